@@ -0,0 +1,108 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// GetAreas retrieves every area in the area registry.
+func (c *Client) GetAreas() ([]Area, error) {
+	return c.GetAreasContext(context.Background())
+}
+
+// GetAreasContext is like GetAreas but uses the provided context.
+func (c *Client) GetAreasContext(ctx context.Context) ([]Area, error) {
+	var areas []Area
+	if err := c.registryCommand(ctx, "area", "list", nil, &areas); err != nil {
+		return nil, fmt.Errorf("failed to list areas: %w", err)
+	}
+
+	return areas, nil
+}
+
+// GetArea retrieves a single area by its area ID.
+// Returns ErrNotFound if no such area exists.
+func (c *Client) GetArea(areaID string) (*Area, error) {
+	return c.GetAreaContext(context.Background(), areaID)
+}
+
+// GetAreaContext is like GetArea but uses the provided context.
+func (c *Client) GetAreaContext(ctx context.Context, areaID string) (*Area, error) {
+	areas, err := c.GetAreasContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range areas {
+		if areas[i].AreaID == areaID {
+			return &areas[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("area %s: %w", areaID, ErrNotFound)
+}
+
+// CreateArea creates a new area and returns it with its generated area ID.
+func (c *Client) CreateArea(area Area) (*Area, error) {
+	return c.CreateAreaContext(context.Background(), area)
+}
+
+// CreateAreaContext is like CreateArea but uses the provided context.
+func (c *Client) CreateAreaContext(ctx context.Context, area Area) (*Area, error) {
+	var created Area
+	if err := c.registryCommand(ctx, "area", "create", withoutNulls(areaPayload(area)), &created); err != nil {
+		return nil, fmt.Errorf("failed to create area: %w", err)
+	}
+
+	return &created, nil
+}
+
+// UpdateArea replaces the settings of an existing area.
+// Empty optional fields are cleared.
+func (c *Client) UpdateArea(areaID string, area Area) (*Area, error) {
+	return c.UpdateAreaContext(context.Background(), areaID, area)
+}
+
+// UpdateAreaContext is like UpdateArea but uses the provided context.
+func (c *Client) UpdateAreaContext(ctx context.Context, areaID string, area Area) (*Area, error) {
+	payload := areaPayload(area)
+	payload["area_id"] = areaID
+
+	var updated Area
+	if err := c.registryCommand(ctx, "area", "update", payload, &updated); err != nil {
+		return nil, fmt.Errorf("failed to update area %s: %w", areaID, err)
+	}
+
+	return &updated, nil
+}
+
+// DeleteArea deletes an area. Entities and devices in the area are unassigned.
+func (c *Client) DeleteArea(areaID string) error {
+	return c.DeleteAreaContext(context.Background(), areaID)
+}
+
+// DeleteAreaContext is like DeleteArea but uses the provided context.
+func (c *Client) DeleteAreaContext(ctx context.Context, areaID string) error {
+	payload := map[string]interface{}{
+		"area_id": areaID,
+	}
+
+	if err := c.registryCommand(ctx, "area", "delete", payload, nil); err != nil {
+		return fmt.Errorf("failed to delete area %s: %w", areaID, err)
+	}
+
+	return nil
+}
+
+// areaPayload builds an update payload for the area. Empty optional fields are
+// sent as null.
+func areaPayload(area Area) map[string]interface{} {
+	return map[string]interface{}{
+		"name":     area.Name,
+		"aliases":  nonNilStrings(area.Aliases),
+		"floor_id": NullableString(area.FloorID),
+		"icon":     NullableString(area.Icon),
+		"picture":  NullableString(area.Picture),
+		"labels":   nonNilStrings(area.Labels),
+	}
+}
@@ -0,0 +1,55 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// GetAutomationConfig retrieves the configuration of an automation by its id.
+func (c *Client) GetAutomationConfig(id string) (map[string]interface{}, error) {
+	return c.GetAutomationConfigContext(context.Background(), id)
+}
+
+// GetAutomationConfigContext is like GetAutomationConfig but uses the provided context.
+func (c *Client) GetAutomationConfigContext(ctx context.Context, id string) (map[string]interface{}, error) {
+	var config map[string]interface{}
+	if err := c.GetConfigItemContext(ctx, "automation", id, &config); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+// SaveAutomationConfig creates or replaces the automation with the given id.
+func (c *Client) SaveAutomationConfig(id string, config map[string]interface{}) error {
+	return c.SaveAutomationConfigContext(context.Background(), id, config)
+}
+
+// SaveAutomationConfigContext is like SaveAutomationConfig but uses the provided context.
+func (c *Client) SaveAutomationConfigContext(ctx context.Context, id string, config map[string]interface{}) error {
+	return c.SaveConfigItemContext(ctx, "automation", id, config)
+}
+
+// DeleteAutomationConfig deletes the automation with the given id.
+func (c *Client) DeleteAutomationConfig(id string) error {
+	return c.DeleteAutomationConfigContext(context.Background(), id)
+}
+
+// DeleteAutomationConfigContext is like DeleteAutomationConfig but uses the provided context.
+func (c *Client) DeleteAutomationConfigContext(ctx context.Context, id string) error {
+	return c.DeleteConfigItemContext(ctx, "automation", id)
+}
+
+// ReloadAutomations reloads all automations so that configuration changes take effect.
+func (c *Client) ReloadAutomations() error {
+	return c.ReloadAutomationsContext(context.Background())
+}
+
+// ReloadAutomationsContext is like ReloadAutomations but uses the provided context.
+func (c *Client) ReloadAutomationsContext(ctx context.Context) error {
+	if _, err := c.CallServiceContext(WithRetrySafe(ctx), "automation", "reload", nil); err != nil {
+		return fmt.Errorf("failed to reload automations: %w", err)
+	}
+
+	return nil
+}
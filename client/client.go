@@ -2,10 +2,16 @@ package client
 
 import (
 	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"os"
+	"strings"
+	"sync"
 	"time"
 )
 
@@ -14,6 +20,143 @@ type Client struct {
 	BaseURL    string
 	Token      string
 	HTTPClient *http.Client
+
+	// WSTimeout bounds each WebSocket command. Zero means no timeout.
+	WSTimeout time.Duration
+
+	// Retry controls retries of transient failures. The zero value disables retries.
+	Retry RetryPolicy
+
+	wsMu sync.Mutex
+	ws   *WSClient
+}
+
+// DefaultTimeout is the timeout applied to REST requests and WebSocket
+// commands when none is configured.
+const DefaultTimeout = 30 * time.Second
+
+// Options configures a Client created with New.
+type Options struct {
+	// BaseURL is the REST API base URL (e.g., http://homeassistant.local:8123/api).
+	BaseURL string
+
+	// Token is a long-lived access token.
+	Token string
+
+	// HTTPClient is used for REST requests. If nil, a client using Timeout is created.
+	HTTPClient *http.Client
+
+	// Timeout bounds each REST request made by the default HTTP client.
+	// Defaults to DefaultTimeout. Ignored when HTTPClient is set.
+	Timeout time.Duration
+
+	// WSTimeout bounds each WebSocket command. Defaults to DefaultTimeout.
+	WSTimeout time.Duration
+
+	// Retry controls retries of transient failures. Defaults to DefaultRetryPolicy.
+	Retry *RetryPolicy
+
+	// TLS configures certificate verification and client certificates for
+	// HTTPS instances. Ignored when HTTPClient is set.
+	TLS *TLSOptions
+}
+
+// TLSOptions configures transport security for instances served over HTTPS.
+type TLSOptions struct {
+	// InsecureSkipVerify disables verification of the server certificate.
+	InsecureSkipVerify bool
+
+	// CACertPEM holds PEM encoded CA certificates trusted in addition to the system pool.
+	CACertPEM []byte
+
+	// ClientCertPEM and ClientKeyPEM hold a PEM encoded certificate and key
+	// presented to the server for mutual TLS. Both or neither must be set.
+	ClientCertPEM []byte
+	ClientKeyPEM  []byte
+}
+
+// Config builds a tls.Config from the options.
+func (o *TLSOptions) Config() (*tls.Config, error) {
+	config := &tls.Config{
+		InsecureSkipVerify: o.InsecureSkipVerify,
+	}
+
+	if len(o.CACertPEM) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(o.CACertPEM) {
+			return nil, fmt.Errorf("no valid certificates found in CA certificate PEM")
+		}
+		config.RootCAs = pool
+	}
+
+	if len(o.ClientCertPEM) > 0 || len(o.ClientKeyPEM) > 0 {
+		if len(o.ClientCertPEM) == 0 || len(o.ClientKeyPEM) == 0 {
+			return nil, fmt.Errorf("client certificate and client key must be set together")
+		}
+		cert, err := tls.X509KeyPair(o.ClientCertPEM, o.ClientKeyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+
+	return config, nil
+}
+
+// New creates a new Home Assistant API client from explicit options.
+// Unlike NewClient it does not read the environment, so several clients
+// for different instances can coexist in one process.
+func New(opts Options) (*Client, error) {
+	if opts.Token == "" {
+		return nil, fmt.Errorf("token is required")
+	}
+
+	if opts.BaseURL == "" {
+		return nil, fmt.Errorf("base URL is required")
+	}
+
+	timeout := opts.Timeout
+	if timeout == 0 {
+		timeout = DefaultTimeout
+	}
+
+	httpClient := opts.HTTPClient
+	if httpClient == nil {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		if opts.TLS != nil {
+			tlsConfig, err := opts.TLS.Config()
+			if err != nil {
+				return nil, err
+			}
+			transport.TLSClientConfig = tlsConfig
+		}
+
+		httpClient = &http.Client{
+			Timeout:   timeout,
+			Transport: transport,
+		}
+	}
+
+	wsTimeout := opts.WSTimeout
+	if wsTimeout == 0 {
+		wsTimeout = DefaultTimeout
+	}
+
+	retry := DefaultRetryPolicy()
+	if opts.Retry != nil {
+		retry = *opts.Retry
+	}
+
+	return &Client{
+		BaseURL:    strings.TrimSuffix(opts.BaseURL, "/"),
+		Token:      opts.Token,
+		HTTPClient: httpClient,
+		WSTimeout:  wsTimeout,
+		Retry:      retry,
+	}, nil
 }
 
 // NewClient creates a new Home Assistant API client.
@@ -37,31 +180,52 @@ func NewClient() (*Client, error) {
 		port = "8123"
 	}
 
-	baseURL := fmt.Sprintf("http://%s:%s/api", hostName, port)
-
-	return &Client{
-		BaseURL: baseURL,
+	return New(Options{
+		BaseURL: fmt.Sprintf("http://%s:%s/api", hostName, port),
 		Token:   token,
-		HTTPClient: &http.Client{
-			Timeout: 30 * time.Second,
-		},
-	}, nil
+	})
 }
 
 // doRequest executes an HTTP request with proper authentication headers.
-func (c *Client) doRequest(method, endpoint string, body []byte) ([]byte, error) {
+// The request is aborted if ctx is cancelled or its deadline expires.
+// Transient failures are retried according to the client's RetryPolicy.
+func (c *Client) doRequest(ctx context.Context, method, endpoint string, body []byte) ([]byte, error) {
 	url := fmt.Sprintf("%s%s", c.BaseURL, endpoint)
 
+	for retry := 0; ; retry++ {
+		resp, respBody, err := c.doAttempt(ctx, method, url, body)
+
+		if retry < c.Retry.MaxRetries && c.Retry.retryable(ctx, method, resp, err) {
+			if err := sleepContext(ctx, c.Retry.backoff(retry+1, resp)); err != nil {
+				return nil, fmt.Errorf("request aborted while waiting to retry: %w", err)
+			}
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to execute request: %w", err)
+		}
+
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			return nil, newAPIError(method, endpoint, resp.StatusCode, respBody)
+		}
+
+		return respBody, nil
+	}
+}
+
+// doAttempt performs a single HTTP request and reads the full response body.
+func (c *Client) doAttempt(ctx context.Context, method, url string, body []byte) (*http.Response, []byte, error) {
 	var req *http.Request
 	var err error
 
 	if body != nil {
-		req, err = http.NewRequest(method, url, bytes.NewReader(body))
+		req, err = http.NewRequestWithContext(ctx, method, url, bytes.NewReader(body))
 	} else {
-		req, err = http.NewRequest(method, url, nil)
+		req, err = http.NewRequestWithContext(ctx, method, url, nil)
 	}
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return nil, nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.Token))
@@ -69,18 +233,72 @@ func (c *Client) doRequest(method, endpoint string, body []byte) ([]byte, error)
 
 	resp, err := c.HTTPClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to execute request: %w", err)
+		return nil, nil, err
 	}
 	defer resp.Body.Close()
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		return nil, nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	return resp, respBody, nil
+}
+
+// WebSocket returns a connected WebSocket API client for the same instance,
+// dialling and authenticating on first use. The connection is shared by all
+// callers and re-established if it has dropped.
+func (c *Client) WebSocket() (*WSClient, error) {
+	return c.WebSocketContext(context.Background())
+}
+
+// WebSocketContext is like WebSocket but dials using the provided context.
+func (c *Client) WebSocketContext(ctx context.Context) (*WSClient, error) {
+	c.wsMu.Lock()
+	defer c.wsMu.Unlock()
+
+	if c.ws != nil && c.ws.Connected() {
+		return c.ws, nil
+	}
+
+	wsURL, err := websocketURL(c.BaseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	for retry := 0; ; retry++ {
+		ws := NewWSClient(wsURL, c.Token)
+		ws.Timeout = c.WSTimeout
+		ws.TLSConfig = c.tlsConfig()
+
+		err := ws.ConnectContext(ctx)
+		if err == nil {
+			c.ws = ws
+			return ws, nil
+		}
+
+		// A rejected token will not succeed on a later attempt
+		var authErr *AuthError
+		if errors.As(err, &authErr) || retry >= c.Retry.MaxRetries || ctx.Err() != nil {
+			return nil, err
+		}
+
+		if err := sleepContext(ctx, c.Retry.backoff(retry+1, nil)); err != nil {
+			return nil, fmt.Errorf("websocket connection aborted while waiting to retry: %w", err)
+		}
+	}
+}
+
+// tlsConfig returns the TLS configuration of the REST transport, if any,
+// so that the WebSocket connection trusts the same certificates.
+func (c *Client) tlsConfig() *tls.Config {
+	if c.HTTPClient == nil {
+		return nil
 	}
 
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(respBody))
+	if transport, ok := c.HTTPClient.Transport.(*http.Transport); ok && transport.TLSClientConfig != nil {
+		return transport.TLSClientConfig.Clone()
 	}
 
-	return respBody, nil
+	return nil
 }
@@ -1,11 +1,23 @@
 package client
 
 import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"testing"
+	"time"
 )
 
 func TestNewClient_Success(t *testing.T) {
@@ -80,6 +92,77 @@ func TestNewClient_MissingIPAddress(t *testing.T) {
 	}
 }
 
+func TestNew_Success(t *testing.T) {
+	client, err := New(Options{
+		BaseURL: "https://ha.example.com/api/",
+		Token:   "test-token",
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if client.BaseURL != "https://ha.example.com/api" {
+		t.Errorf("expected trailing slash to be trimmed, got %s", client.BaseURL)
+	}
+	if client.HTTPClient == nil || client.HTTPClient.Timeout != DefaultTimeout {
+		t.Errorf("expected default HTTP client with %s timeout", DefaultTimeout)
+	}
+	if client.WSTimeout != DefaultTimeout {
+		t.Errorf("expected WSTimeout %s, got %s", DefaultTimeout, client.WSTimeout)
+	}
+}
+
+func TestNew_CustomHTTPClientAndTimeouts(t *testing.T) {
+	httpClient := &http.Client{}
+	client, err := New(Options{
+		BaseURL:    "http://localhost:8123/api",
+		Token:      "test-token",
+		HTTPClient: httpClient,
+		WSTimeout:  5 * time.Second,
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if client.HTTPClient != httpClient {
+		t.Error("expected the provided HTTP client to be used")
+	}
+	if client.WSTimeout != 5*time.Second {
+		t.Errorf("expected WSTimeout 5s, got %s", client.WSTimeout)
+	}
+}
+
+func TestNew_MissingToken(t *testing.T) {
+	_, err := New(Options{BaseURL: "http://localhost:8123/api"})
+	if err == nil {
+		t.Fatal("expected error for missing token")
+	}
+}
+
+func TestNew_MissingBaseURL(t *testing.T) {
+	_, err := New(Options{Token: "test-token"})
+	if err == nil {
+		t.Fatal("expected error for missing base URL")
+	}
+}
+
+func TestNew_DoesNotReadEnvironment(t *testing.T) {
+	os.Setenv("HA_BEARER_TOKEN", "env-token")
+	defer os.Unsetenv("HA_BEARER_TOKEN")
+
+	client, err := New(Options{
+		BaseURL: "http://office.local:8123/api",
+		Token:   "office-token",
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if client.Token != "office-token" {
+		t.Errorf("expected Token 'office-token', got %s", client.Token)
+	}
+}
+
 // createTestClient creates a client pointing to a test server
 func createTestClient(server *httptest.Server) *Client {
 	return &Client{
@@ -355,6 +438,107 @@ func TestClient_ErrorResponse(t *testing.T) {
 	if err == nil {
 		t.Fatal("expected error for 404 response")
 	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected APIError, got %T: %v", err, err)
+	}
+	if apiErr.StatusCode != http.StatusNotFound {
+		t.Errorf("expected status 404, got %d", apiErr.StatusCode)
+	}
+	if apiErr.Method != "GET" {
+		t.Errorf("expected method GET, got %s", apiErr.Method)
+	}
+	if apiErr.Endpoint != "/states/light.nonexistent" {
+		t.Errorf("expected endpoint '/states/light.nonexistent', got %s", apiErr.Endpoint)
+	}
+	if apiErr.Message != "Entity not found" {
+		t.Errorf("expected message 'Entity not found', got %s", apiErr.Message)
+	}
+	if !IsNotFound(err) {
+		t.Error("expected IsNotFound to be true")
+	}
+	if IsUnauthorized(err) {
+		t.Error("expected IsUnauthorized to be false")
+	}
+}
+
+func TestClient_ErrorResponseJSONMessage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"message": "Invalid JSON specified."}`))
+	}))
+	defer server.Close()
+
+	client := createTestClient(server)
+	_, err := client.SetState("light.living_room", StateUpdateRequest{State: "on"})
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected APIError, got %T: %v", err, err)
+	}
+	if apiErr.Message != "Invalid JSON specified." {
+		t.Errorf("expected message 'Invalid JSON specified.', got %s", apiErr.Message)
+	}
+	if IsNotFound(err) {
+		t.Error("expected IsNotFound to be false for 400")
+	}
+}
+
+func TestClient_ErrorResponseUnauthorized(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte("401: Unauthorized"))
+	}))
+	defer server.Close()
+
+	client := createTestClient(server)
+	_, err := client.GetState("light.living_room")
+
+	if !IsUnauthorized(err) {
+		t.Errorf("expected IsUnauthorized to be true, got %v", err)
+	}
+	if IsNotFound(err) {
+		t.Error("expected IsNotFound to be false for 401")
+	}
+}
+
+func TestIsNotFound(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{"nil", nil, false},
+		{"sentinel", fmt.Errorf("zone office: %w", ErrNotFound), true},
+		{"websocket not_found", &WSError{Code: "not_found"}, true},
+		{"websocket other", &WSError{Code: "invalid_format"}, false},
+		{"server error", &APIError{StatusCode: http.StatusInternalServerError}, false},
+		{"network", errors.New("connection refused"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsNotFound(tt.err); got != tt.expected {
+				t.Errorf("IsNotFound(%v) = %v, expected %v", tt.err, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestClient_NetworkErrorIsNotNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	client := createTestClient(server)
+	server.Close()
+
+	_, err := client.GetState("light.living_room")
+	if err == nil {
+		t.Fatal("expected error for closed server")
+	}
+	if IsNotFound(err) {
+		t.Error("expected IsNotFound to be false for network errors")
+	}
 }
 
 func TestClient_GetServices(t *testing.T) {
@@ -393,3 +577,195 @@ func TestClient_GetServices(t *testing.T) {
 		t.Errorf("expected 2 services, got %d", len(services[0].Services))
 	}
 }
+
+func TestClient_GetStateContextCancelled(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Hold the request until the client gives up
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	client := createTestClient(server)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := client.GetStateContext(ctx, "light.living_room")
+	if err == nil {
+		t.Fatal("expected error for cancelled context")
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
+// serverCertPEM returns the PEM encoded certificate of a TLS test server
+func serverCertPEM(server *httptest.Server) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+}
+
+// generateClientCert creates a self-signed client certificate and key for mutual TLS tests
+func generateClientCert(t *testing.T) (certPEM, keyPEM []byte, cert *x509.Certificate) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "terraform"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+	cert, err = x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("failed to parse certificate: %v", err)
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("failed to marshal key: %v", err)
+	}
+
+	certPEM = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM = pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+
+	return certPEM, keyPEM, cert
+}
+
+func healthHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(APIStatus{Message: "API running."})
+}
+
+func TestNew_TLSWithCACert(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(healthHandler))
+	defer server.Close()
+
+	client, err := New(Options{
+		BaseURL: server.URL,
+		Token:   "test-token",
+		TLS:     &TLSOptions{CACertPEM: serverCertPEM(server)},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if _, err := client.Health(); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func TestNew_TLSUntrustedCert(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(healthHandler))
+	defer server.Close()
+
+	client, err := New(Options{
+		BaseURL: server.URL,
+		Token:   "test-token",
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if _, err := client.Health(); err == nil {
+		t.Fatal("expected certificate verification error")
+	}
+}
+
+func TestNew_TLSInsecureSkipVerify(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(healthHandler))
+	defer server.Close()
+
+	client, err := New(Options{
+		BaseURL: server.URL,
+		Token:   "test-token",
+		TLS:     &TLSOptions{InsecureSkipVerify: true},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if _, err := client.Health(); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func TestNew_TLSClientCertificate(t *testing.T) {
+	certPEM, keyPEM, cert := generateClientCert(t)
+
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(cert)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(healthHandler))
+	server.TLS = &tls.Config{
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  clientCAs,
+	}
+	server.StartTLS()
+	defer server.Close()
+
+	client, err := New(Options{
+		BaseURL: server.URL,
+		Token:   "test-token",
+		TLS: &TLSOptions{
+			CACertPEM:     serverCertPEM(server),
+			ClientCertPEM: certPEM,
+			ClientKeyPEM:  keyPEM,
+		},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if _, err := client.Health(); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	// Without the client certificate the handshake must be rejected
+	noCert, err := New(Options{
+		BaseURL: server.URL,
+		Token:   "test-token",
+		TLS:     &TLSOptions{CACertPEM: serverCertPEM(server)},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := noCert.Health(); err == nil {
+		t.Fatal("expected error without client certificate")
+	}
+}
+
+func TestTLSOptions_Invalid(t *testing.T) {
+	certPEM, _, _ := generateClientCert(t)
+
+	tests := map[string]TLSOptions{
+		"bad CA":           {CACertPEM: []byte("not a certificate")},
+		"cert without key": {ClientCertPEM: certPEM},
+		"mismatched key":   {ClientCertPEM: certPEM, ClientKeyPEM: []byte("not a key")},
+	}
+
+	for name, opts := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := opts.Config(); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
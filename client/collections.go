@@ -0,0 +1,132 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// ListCollection retrieves every item of a storage collection (e.g. zone, input_boolean)
+// and decodes them into out, which should be a pointer to a slice.
+func (c *Client) ListCollection(domain string, out interface{}) error {
+	return c.ListCollectionContext(context.Background(), domain, out)
+}
+
+// ListCollectionContext is like ListCollection but uses the provided context.
+func (c *Client) ListCollectionContext(ctx context.Context, domain string, out interface{}) error {
+	ws, err := c.WebSocketContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := ws.CommandContext(ctx, domain+"/list", nil, out); err != nil {
+		return fmt.Errorf("failed to list %s items: %w", domain, err)
+	}
+
+	return nil
+}
+
+// GetCollectionItem retrieves a single item of a storage collection by its id.
+// Returns ErrNotFound if no such item exists.
+func (c *Client) GetCollectionItem(domain, id string) (map[string]interface{}, error) {
+	return c.GetCollectionItemContext(context.Background(), domain, id)
+}
+
+// GetCollectionItemContext is like GetCollectionItem but uses the provided context.
+func (c *Client) GetCollectionItemContext(ctx context.Context, domain, id string) (map[string]interface{}, error) {
+	var items []map[string]interface{}
+	if err := c.ListCollectionContext(ctx, domain, &items); err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		if itemID, _ := item["id"].(string); itemID == id {
+			return item, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%s %s: %w", domain, id, ErrNotFound)
+}
+
+// CreateCollectionItem creates an item in a storage collection and decodes
+// the created item, including its generated id, into out.
+func (c *Client) CreateCollectionItem(domain string, payload map[string]interface{}, out interface{}) error {
+	return c.CreateCollectionItemContext(context.Background(), domain, payload, out)
+}
+
+// CreateCollectionItemContext is like CreateCollectionItem but uses the provided context.
+func (c *Client) CreateCollectionItemContext(ctx context.Context, domain string, payload map[string]interface{}, out interface{}) error {
+	ws, err := c.WebSocketContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := ws.CommandContext(ctx, domain+"/create", payload, out); err != nil {
+		return fmt.Errorf("failed to create %s item: %w", domain, err)
+	}
+
+	return nil
+}
+
+// UpdateCollectionItem replaces the fields of an existing storage collection item.
+func (c *Client) UpdateCollectionItem(domain, id string, payload map[string]interface{}, out interface{}) error {
+	return c.UpdateCollectionItemContext(context.Background(), domain, id, payload, out)
+}
+
+// UpdateCollectionItemContext is like UpdateCollectionItem but uses the provided context.
+func (c *Client) UpdateCollectionItemContext(ctx context.Context, domain, id string, payload map[string]interface{}, out interface{}) error {
+	ws, err := c.WebSocketContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	msg := make(map[string]interface{}, len(payload)+1)
+	for k, v := range payload {
+		msg[k] = v
+	}
+	msg[domain+"_id"] = id
+
+	if err := ws.CommandContext(ctx, domain+"/update", msg, out); err != nil {
+		return fmt.Errorf("failed to update %s item %s: %w", domain, id, err)
+	}
+
+	return nil
+}
+
+// DeleteCollectionItem deletes an item from a storage collection.
+func (c *Client) DeleteCollectionItem(domain, id string) error {
+	return c.DeleteCollectionItemContext(context.Background(), domain, id)
+}
+
+// DeleteCollectionItemContext is like DeleteCollectionItem but uses the provided context.
+func (c *Client) DeleteCollectionItemContext(ctx context.Context, domain, id string) error {
+	ws, err := c.WebSocketContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	payload := map[string]interface{}{
+		domain + "_id": id,
+	}
+
+	if err := ws.CommandContext(ctx, domain+"/delete", payload, nil); err != nil {
+		return fmt.Errorf("failed to delete %s item %s: %w", domain, id, err)
+	}
+
+	return nil
+}
+
+// toPayload converts a struct into a command payload using its JSON field names.
+func toPayload(v interface{}) (map[string]interface{}, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	var payload map[string]interface{}
+	if err := json.Unmarshal(raw, &payload); err != nil {
+		return nil, fmt.Errorf("failed to build payload: %w", err)
+	}
+
+	return payload, nil
+}
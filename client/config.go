@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -8,7 +9,12 @@ import (
 // Health checks if the Home Assistant API is running.
 // Returns the API status message on success.
 func (c *Client) Health() (*APIStatus, error) {
-	body, err := c.doRequest("GET", "/", nil)
+	return c.HealthContext(context.Background())
+}
+
+// HealthContext is like Health but uses the provided context.
+func (c *Client) HealthContext(ctx context.Context) (*APIStatus, error) {
+	body, err := c.doRequest(ctx, "GET", "/", nil)
 	if err != nil {
 		return nil, fmt.Errorf("health check failed: %w", err)
 	}
@@ -23,7 +29,12 @@ func (c *Client) Health() (*APIStatus, error) {
 
 // GetConfig retrieves the current Home Assistant configuration.
 func (c *Client) GetConfig() (*Config, error) {
-	body, err := c.doRequest("GET", "/config", nil)
+	return c.GetConfigContext(context.Background())
+}
+
+// GetConfigContext is like GetConfig but uses the provided context.
+func (c *Client) GetConfigContext(ctx context.Context) (*Config, error) {
+	body, err := c.doRequest(ctx, "GET", "/config", nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get config: %w", err)
 	}
@@ -0,0 +1,70 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// GetConfigItem retrieves an item stored through the config API (e.g.
+// automation, script, scene) and decodes its configuration into out.
+// Returns an APIError with status 404 if the item does not exist.
+func (c *Client) GetConfigItem(domain, id string, out interface{}) error {
+	return c.GetConfigItemContext(context.Background(), domain, id, out)
+}
+
+// GetConfigItemContext is like GetConfigItem but uses the provided context.
+func (c *Client) GetConfigItemContext(ctx context.Context, domain, id string, out interface{}) error {
+	body, err := c.doRequest(ctx, "GET", configItemEndpoint(domain, id), nil)
+	if err != nil {
+		return fmt.Errorf("failed to get %s config %s: %w", domain, id, err)
+	}
+
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("failed to parse %s config response: %w", domain, err)
+	}
+
+	return nil
+}
+
+// SaveConfigItem creates or replaces an item stored through the config API.
+// Home Assistant validates the configuration before writing it.
+func (c *Client) SaveConfigItem(domain, id string, config interface{}) error {
+	return c.SaveConfigItemContext(context.Background(), domain, id, config)
+}
+
+// SaveConfigItemContext is like SaveConfigItem but uses the provided context.
+func (c *Client) SaveConfigItemContext(ctx context.Context, domain, id string, config interface{}) error {
+	payload, err := json.Marshal(config)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s config: %w", domain, err)
+	}
+
+	// Writing the same configuration twice has no further effect
+	_, err = c.doRequest(WithRetrySafe(ctx), "POST", configItemEndpoint(domain, id), payload)
+	if err != nil {
+		return fmt.Errorf("failed to save %s config %s: %w", domain, id, err)
+	}
+
+	return nil
+}
+
+// DeleteConfigItem deletes an item stored through the config API.
+func (c *Client) DeleteConfigItem(domain, id string) error {
+	return c.DeleteConfigItemContext(context.Background(), domain, id)
+}
+
+// DeleteConfigItemContext is like DeleteConfigItem but uses the provided context.
+func (c *Client) DeleteConfigItemContext(ctx context.Context, domain, id string) error {
+	_, err := c.doRequest(ctx, "DELETE", configItemEndpoint(domain, id), nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete %s config %s: %w", domain, id, err)
+	}
+
+	return nil
+}
+
+func configItemEndpoint(domain, id string) string {
+	return fmt.Sprintf("/config/%s/config/%s", url.PathEscape(domain), url.PathEscape(id))
+}
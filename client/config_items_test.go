@@ -0,0 +1,217 @@
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// newConfigStoreServer fakes the config API for a single domain, keeping
+// items in memory. Reload service calls are counted in reloads.
+func newConfigStoreServer(t *testing.T, domain string, reloads *int) *httptest.Server {
+	t.Helper()
+
+	var mu sync.Mutex
+	items := make(map[string]json.RawMessage)
+	prefix := "/config/" + domain + "/config/"
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Path == "/services/"+domain+"/reload" {
+			if reloads != nil {
+				*reloads++
+			}
+			w.Write([]byte("[]"))
+			return
+		}
+
+		if !strings.HasPrefix(r.URL.Path, prefix) {
+			t.Errorf("unexpected path %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		id := strings.TrimPrefix(r.URL.Path, prefix)
+
+		switch r.Method {
+		case "GET":
+			item, ok := items[id]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(`{"message": "Resource not found"}`))
+				return
+			}
+			w.Write(item)
+		case "POST":
+			var item json.RawMessage
+			if err := json.NewDecoder(r.Body).Decode(&item); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(`{"message": "Message format incorrect"}`))
+				return
+			}
+			items[id] = item
+			w.Write([]byte(`{"result": "ok"}`))
+		case "DELETE":
+			if _, ok := items[id]; !ok {
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(`{"message": "Resource not found"}`))
+				return
+			}
+			delete(items, id)
+			w.Write([]byte(`{"result": "ok"}`))
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+}
+
+func TestClient_ConfigItemLifecycle(t *testing.T) {
+	server := newConfigStoreServer(t, "automation", nil)
+	defer server.Close()
+
+	client := createTestClient(server)
+
+	config := map[string]interface{}{
+		"alias":    "Porch light",
+		"triggers": []interface{}{map[string]interface{}{"trigger": "sun", "event": "sunset"}},
+	}
+	if err := client.SaveConfigItem("automation", "porch_light", config); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	var got map[string]interface{}
+	if err := client.GetConfigItem("automation", "porch_light", &got); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got["alias"] != "Porch light" {
+		t.Errorf("expected alias 'Porch light', got %v", got["alias"])
+	}
+
+	if err := client.DeleteConfigItem("automation", "porch_light"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	err := client.GetConfigItem("automation", "porch_light", &got)
+	if !IsNotFound(err) {
+		t.Errorf("expected not found error after delete, got %v", err)
+	}
+}
+
+func TestClient_ConfigItemEscapesID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.EscapedPath() != "/config/automation/config/a%2Fb" {
+			t.Errorf("expected escaped id in path, got %s", r.URL.EscapedPath())
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := createTestClient(server)
+
+	var got map[string]interface{}
+	if err := client.GetConfigItem("automation", "a/b", &got); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func TestClient_SaveAutomationConfigAndReload(t *testing.T) {
+	reloads := 0
+	server := newConfigStoreServer(t, "automation", &reloads)
+	defer server.Close()
+
+	client := createTestClient(server)
+
+	if err := client.SaveAutomationConfig("1700000000000", map[string]interface{}{"alias": "Test"}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if err := client.ReloadAutomations(); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if reloads != 1 {
+		t.Errorf("expected 1 reload, got %d", reloads)
+	}
+
+	config, err := client.GetAutomationConfig("1700000000000")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if config["alias"] != "Test" {
+		t.Errorf("expected alias 'Test', got %v", config["alias"])
+	}
+}
+
+func TestClient_SaveScriptConfigAndReload(t *testing.T) {
+	reloads := 0
+	server := newConfigStoreServer(t, "script", &reloads)
+	defer server.Close()
+
+	client := createTestClient(server)
+
+	config := map[string]interface{}{
+		"alias":    "Goodnight",
+		"sequence": []interface{}{map[string]interface{}{"action": "light.turn_off"}},
+	}
+	if err := client.SaveScriptConfig("goodnight", config); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if err := client.ReloadScripts(); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if reloads != 1 {
+		t.Errorf("expected 1 reload, got %d", reloads)
+	}
+
+	got, err := client.GetScriptConfig("goodnight")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got["alias"] != "Goodnight" {
+		t.Errorf("expected alias 'Goodnight', got %v", got["alias"])
+	}
+
+	if err := client.DeleteScriptConfig("goodnight"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func TestClient_SaveSceneConfigAndReload(t *testing.T) {
+	reloads := 0
+	server := newConfigStoreServer(t, "scene", &reloads)
+	defer server.Close()
+
+	client := createTestClient(server)
+
+	config := map[string]interface{}{
+		"name": "Movie night",
+		"entities": map[string]interface{}{
+			"light.tv_backlight": map[string]interface{}{"state": "on", "brightness": 40},
+		},
+	}
+	if err := client.SaveSceneConfig("movie_night", config); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if err := client.ReloadScenes(); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if reloads != 1 {
+		t.Errorf("expected 1 reload, got %d", reloads)
+	}
+
+	got, err := client.GetSceneConfig("movie_night")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got["name"] != "Movie night" {
+		t.Errorf("expected name 'Movie night', got %v", got["name"])
+	}
+
+	if err := client.DeleteSceneConfig("movie_night"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
@@ -0,0 +1,93 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// ErrNotFound is returned when a requested item does not exist.
+var ErrNotFound = errors.New("not found")
+
+// APIError is returned when the REST API responds with a non-2xx status.
+type APIError struct {
+	StatusCode int
+	Method     string
+	Endpoint   string
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("API request %s %s failed with status %d: %s", e.Method, e.Endpoint, e.StatusCode, e.Message)
+}
+
+// newAPIError builds an APIError, extracting Home Assistant's message
+// from a JSON body where present and falling back to the raw body.
+func newAPIError(method, endpoint string, statusCode int, body []byte) *APIError {
+	message := strings.TrimSpace(string(body))
+
+	var payload struct {
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal(body, &payload); err == nil && payload.Message != "" {
+		message = payload.Message
+	}
+
+	return &APIError{
+		StatusCode: statusCode,
+		Method:     method,
+		Endpoint:   endpoint,
+		Message:    message,
+	}
+}
+
+// IsNotFound reports whether err indicates that the requested entity or
+// item does not exist, as opposed to a network or authentication failure.
+func IsNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if errors.Is(err, ErrNotFound) {
+		return true
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == http.StatusNotFound
+	}
+
+	var wsErr *WSError
+	if errors.As(err, &wsErr) {
+		return wsErr.Code == "not_found"
+	}
+
+	return false
+}
+
+// IsUnauthorized reports whether err indicates that the access token was
+// rejected or lacks permission.
+func IsUnauthorized(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == http.StatusUnauthorized || apiErr.StatusCode == http.StatusForbidden
+	}
+
+	var authErr *AuthError
+	if errors.As(err, &authErr) {
+		return true
+	}
+
+	var wsErr *WSError
+	if errors.As(err, &wsErr) {
+		return wsErr.Code == "unauthorized"
+	}
+
+	return false
+}
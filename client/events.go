@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -8,7 +9,12 @@ import (
 
 // GetEvents retrieves a list of all event types that can be fired.
 func (c *Client) GetEvents() ([]Event, error) {
-	body, err := c.doRequest("GET", "/events", nil)
+	return c.GetEventsContext(context.Background())
+}
+
+// GetEventsContext is like GetEvents but uses the provided context.
+func (c *Client) GetEventsContext(ctx context.Context) ([]Event, error) {
+	body, err := c.doRequest(ctx, "GET", "/events", nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get events: %w", err)
 	}
@@ -28,6 +34,11 @@ type FireEventResponse struct {
 
 // FireEvent fires an event with the specified type and optional data.
 func (c *Client) FireEvent(eventType string, eventData map[string]interface{}) (*FireEventResponse, error) {
+	return c.FireEventContext(context.Background(), eventType, eventData)
+}
+
+// FireEventContext is like FireEvent but uses the provided context.
+func (c *Client) FireEventContext(ctx context.Context, eventType string, eventData map[string]interface{}) (*FireEventResponse, error) {
 	var payload []byte
 	var err error
 
@@ -39,7 +50,7 @@ func (c *Client) FireEvent(eventType string, eventData map[string]interface{}) (
 	}
 
 	endpoint := fmt.Sprintf("/events/%s", url.PathEscape(eventType))
-	body, err := c.doRequest("POST", endpoint, payload)
+	body, err := c.doRequest(ctx, "POST", endpoint, payload)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fire event %s: %w", eventType, err)
 	}
@@ -0,0 +1,110 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// GetFloors retrieves every floor in the floor registry.
+func (c *Client) GetFloors() ([]Floor, error) {
+	return c.GetFloorsContext(context.Background())
+}
+
+// GetFloorsContext is like GetFloors but uses the provided context.
+func (c *Client) GetFloorsContext(ctx context.Context) ([]Floor, error) {
+	var floors []Floor
+	if err := c.registryCommand(ctx, "floor", "list", nil, &floors); err != nil {
+		return nil, fmt.Errorf("failed to list floors: %w", err)
+	}
+
+	return floors, nil
+}
+
+// GetFloor retrieves a single floor by its floor ID.
+// Returns ErrNotFound if no such floor exists.
+func (c *Client) GetFloor(floorID string) (*Floor, error) {
+	return c.GetFloorContext(context.Background(), floorID)
+}
+
+// GetFloorContext is like GetFloor but uses the provided context.
+func (c *Client) GetFloorContext(ctx context.Context, floorID string) (*Floor, error) {
+	floors, err := c.GetFloorsContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range floors {
+		if floors[i].FloorID == floorID {
+			return &floors[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("floor %s: %w", floorID, ErrNotFound)
+}
+
+// CreateFloor creates a new floor and returns it with its generated floor ID.
+func (c *Client) CreateFloor(floor Floor) (*Floor, error) {
+	return c.CreateFloorContext(context.Background(), floor)
+}
+
+// CreateFloorContext is like CreateFloor but uses the provided context.
+func (c *Client) CreateFloorContext(ctx context.Context, floor Floor) (*Floor, error) {
+	var created Floor
+	if err := c.registryCommand(ctx, "floor", "create", withoutNulls(floorPayload(floor)), &created); err != nil {
+		return nil, fmt.Errorf("failed to create floor: %w", err)
+	}
+
+	return &created, nil
+}
+
+// UpdateFloor replaces the settings of an existing floor.
+// Empty optional fields are cleared.
+func (c *Client) UpdateFloor(floorID string, floor Floor) (*Floor, error) {
+	return c.UpdateFloorContext(context.Background(), floorID, floor)
+}
+
+// UpdateFloorContext is like UpdateFloor but uses the provided context.
+func (c *Client) UpdateFloorContext(ctx context.Context, floorID string, floor Floor) (*Floor, error) {
+	payload := floorPayload(floor)
+	payload["floor_id"] = floorID
+
+	var updated Floor
+	if err := c.registryCommand(ctx, "floor", "update", payload, &updated); err != nil {
+		return nil, fmt.Errorf("failed to update floor %s: %w", floorID, err)
+	}
+
+	return &updated, nil
+}
+
+// DeleteFloor deletes a floor. Areas on the floor are unassigned.
+func (c *Client) DeleteFloor(floorID string) error {
+	return c.DeleteFloorContext(context.Background(), floorID)
+}
+
+// DeleteFloorContext is like DeleteFloor but uses the provided context.
+func (c *Client) DeleteFloorContext(ctx context.Context, floorID string) error {
+	payload := map[string]interface{}{
+		"floor_id": floorID,
+	}
+
+	if err := c.registryCommand(ctx, "floor", "delete", payload, nil); err != nil {
+		return fmt.Errorf("failed to delete floor %s: %w", floorID, err)
+	}
+
+	return nil
+}
+
+// floorPayload builds an update payload for the floor. Empty optional fields
+// are sent as null, and a nil level leaves the floor unordered.
+func floorPayload(floor Floor) map[string]interface{} {
+	payload := map[string]interface{}{
+		"name":    floor.Name,
+		"aliases": nonNilStrings(floor.Aliases),
+		"icon":    NullableString(floor.Icon),
+		"level":   nil,
+	}
+	if floor.Level != nil {
+		payload["level"] = *floor.Level
+	}
+	return payload
+}
@@ -0,0 +1,106 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// GetLabels retrieves every label in the label registry.
+func (c *Client) GetLabels() ([]Label, error) {
+	return c.GetLabelsContext(context.Background())
+}
+
+// GetLabelsContext is like GetLabels but uses the provided context.
+func (c *Client) GetLabelsContext(ctx context.Context) ([]Label, error) {
+	var labels []Label
+	if err := c.registryCommand(ctx, "label", "list", nil, &labels); err != nil {
+		return nil, fmt.Errorf("failed to list labels: %w", err)
+	}
+
+	return labels, nil
+}
+
+// GetLabel retrieves a single label by its label ID.
+// Returns ErrNotFound if no such label exists.
+func (c *Client) GetLabel(labelID string) (*Label, error) {
+	return c.GetLabelContext(context.Background(), labelID)
+}
+
+// GetLabelContext is like GetLabel but uses the provided context.
+func (c *Client) GetLabelContext(ctx context.Context, labelID string) (*Label, error) {
+	labels, err := c.GetLabelsContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range labels {
+		if labels[i].LabelID == labelID {
+			return &labels[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("label %s: %w", labelID, ErrNotFound)
+}
+
+// CreateLabel creates a new label and returns it with its generated label ID.
+func (c *Client) CreateLabel(label Label) (*Label, error) {
+	return c.CreateLabelContext(context.Background(), label)
+}
+
+// CreateLabelContext is like CreateLabel but uses the provided context.
+func (c *Client) CreateLabelContext(ctx context.Context, label Label) (*Label, error) {
+	var created Label
+	if err := c.registryCommand(ctx, "label", "create", withoutNulls(labelPayload(label)), &created); err != nil {
+		return nil, fmt.Errorf("failed to create label: %w", err)
+	}
+
+	return &created, nil
+}
+
+// UpdateLabel replaces the settings of an existing label.
+// Empty optional fields are cleared.
+func (c *Client) UpdateLabel(labelID string, label Label) (*Label, error) {
+	return c.UpdateLabelContext(context.Background(), labelID, label)
+}
+
+// UpdateLabelContext is like UpdateLabel but uses the provided context.
+func (c *Client) UpdateLabelContext(ctx context.Context, labelID string, label Label) (*Label, error) {
+	payload := labelPayload(label)
+	payload["label_id"] = labelID
+
+	var updated Label
+	if err := c.registryCommand(ctx, "label", "update", payload, &updated); err != nil {
+		return nil, fmt.Errorf("failed to update label %s: %w", labelID, err)
+	}
+
+	return &updated, nil
+}
+
+// DeleteLabel deletes a label and removes it from everything it is assigned to.
+func (c *Client) DeleteLabel(labelID string) error {
+	return c.DeleteLabelContext(context.Background(), labelID)
+}
+
+// DeleteLabelContext is like DeleteLabel but uses the provided context.
+func (c *Client) DeleteLabelContext(ctx context.Context, labelID string) error {
+	payload := map[string]interface{}{
+		"label_id": labelID,
+	}
+
+	if err := c.registryCommand(ctx, "label", "delete", payload, nil); err != nil {
+		return fmt.Errorf("failed to delete label %s: %w", labelID, err)
+	}
+
+	return nil
+}
+
+// labelPayload builds an update payload for the label. Empty optional fields are
+// sent as null.
+func labelPayload(label Label) map[string]interface{} {
+	return map[string]interface{}{
+		"name":        label.Name,
+		"color":       NullableString(label.Color),
+		"icon":        NullableString(label.Icon),
+		"description": NullableString(label.Description),
+	}
+}
@@ -0,0 +1,231 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// GetEntityRegistryEntry retrieves the entity registry entry for an entity ID.
+func (c *Client) GetEntityRegistryEntry(entityID string) (*EntityRegistryEntry, error) {
+	return c.GetEntityRegistryEntryContext(context.Background(), entityID)
+}
+
+// GetEntityRegistryEntryContext is like GetEntityRegistryEntry but uses the provided context.
+func (c *Client) GetEntityRegistryEntryContext(ctx context.Context, entityID string) (*EntityRegistryEntry, error) {
+	ws, err := c.WebSocketContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var entry EntityRegistryEntry
+	payload := map[string]interface{}{
+		"entity_id": entityID,
+	}
+	if err := ws.CommandContext(ctx, "config/entity_registry/get", payload, &entry); err != nil {
+		return nil, fmt.Errorf("failed to get entity registry entry for %s: %w", entityID, err)
+	}
+
+	return &entry, nil
+}
+
+// GetEntityRegistryEntries retrieves every entry in the entity registry.
+func (c *Client) GetEntityRegistryEntries() ([]EntityRegistryEntry, error) {
+	return c.GetEntityRegistryEntriesContext(context.Background())
+}
+
+// GetEntityRegistryEntriesContext is like GetEntityRegistryEntries but uses the provided context.
+func (c *Client) GetEntityRegistryEntriesContext(ctx context.Context) ([]EntityRegistryEntry, error) {
+	ws, err := c.WebSocketContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []EntityRegistryEntry
+	if err := ws.CommandContext(ctx, "config/entity_registry/list", nil, &entries); err != nil {
+		return nil, fmt.Errorf("failed to list entity registry: %w", err)
+	}
+
+	return entries, nil
+}
+
+// GetEntityRegistryEntryByID retrieves the entity registry entry with the
+// given registry ID, which unlike the entity ID does not change on rename.
+// Returns ErrNotFound if no entry matches.
+func (c *Client) GetEntityRegistryEntryByID(id string) (*EntityRegistryEntry, error) {
+	return c.GetEntityRegistryEntryByIDContext(context.Background(), id)
+}
+
+// GetEntityRegistryEntryByIDContext is like GetEntityRegistryEntryByID but uses the provided context.
+func (c *Client) GetEntityRegistryEntryByIDContext(ctx context.Context, id string) (*EntityRegistryEntry, error) {
+	entries, err := c.GetEntityRegistryEntriesContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		if entry.ID == id {
+			// The list omits some fields, so fetch the full entry
+			return c.GetEntityRegistryEntryContext(ctx, entry.EntityID)
+		}
+	}
+
+	return nil, fmt.Errorf("entity registry entry %s: %w", id, ErrNotFound)
+}
+
+// UpdateEntityRegistryEntry applies changes to the entity registry entry of
+// an entity and returns the updated entry. Fields not present in changes are
+// left untouched; a nil value resets a field to its default.
+func (c *Client) UpdateEntityRegistryEntry(entityID string, changes map[string]interface{}) (*EntityRegistryEntry, error) {
+	return c.UpdateEntityRegistryEntryContext(context.Background(), entityID, changes)
+}
+
+// UpdateEntityRegistryEntryContext is like UpdateEntityRegistryEntry but uses the provided context.
+func (c *Client) UpdateEntityRegistryEntryContext(ctx context.Context, entityID string, changes map[string]interface{}) (*EntityRegistryEntry, error) {
+	ws, err := c.WebSocketContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := make(map[string]interface{}, len(changes)+1)
+	for k, v := range changes {
+		payload[k] = v
+	}
+	payload["entity_id"] = entityID
+
+	var result struct {
+		EntityEntry EntityRegistryEntry `json:"entity_entry"`
+	}
+	if err := ws.CommandContext(ctx, "config/entity_registry/update", payload, &result); err != nil {
+		return nil, fmt.Errorf("failed to update entity registry entry for %s: %w", entityID, err)
+	}
+
+	return &result.EntityEntry, nil
+}
+
+// FindEntityID looks up the entity ID registered by an integration platform
+// for the given unique ID. Returns ErrNotFound if no entity matches.
+func (c *Client) FindEntityID(platform, uniqueID string) (string, error) {
+	return c.FindEntityIDContext(context.Background(), platform, uniqueID)
+}
+
+// FindEntityIDContext is like FindEntityID but uses the provided context.
+func (c *Client) FindEntityIDContext(ctx context.Context, platform, uniqueID string) (string, error) {
+	entries, err := c.GetEntityRegistryEntriesContext(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	for _, entry := range entries {
+		if entry.Platform == platform && entry.UniqueID == uniqueID {
+			return entry.EntityID, nil
+		}
+	}
+
+	return "", fmt.Errorf("entity for %s %s: %w", platform, uniqueID, ErrNotFound)
+}
+
+// GetDeviceRegistryEntries retrieves every entry in the device registry.
+func (c *Client) GetDeviceRegistryEntries() ([]DeviceRegistryEntry, error) {
+	return c.GetDeviceRegistryEntriesContext(context.Background())
+}
+
+// GetDeviceRegistryEntriesContext is like GetDeviceRegistryEntries but uses the provided context.
+func (c *Client) GetDeviceRegistryEntriesContext(ctx context.Context) ([]DeviceRegistryEntry, error) {
+	ws, err := c.WebSocketContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []DeviceRegistryEntry
+	if err := ws.CommandContext(ctx, "config/device_registry/list", nil, &entries); err != nil {
+		return nil, fmt.Errorf("failed to list device registry: %w", err)
+	}
+
+	return entries, nil
+}
+
+// GetDeviceRegistryEntry retrieves a single device registry entry by device ID.
+// Returns ErrNotFound if no such device exists.
+func (c *Client) GetDeviceRegistryEntry(deviceID string) (*DeviceRegistryEntry, error) {
+	return c.GetDeviceRegistryEntryContext(context.Background(), deviceID)
+}
+
+// GetDeviceRegistryEntryContext is like GetDeviceRegistryEntry but uses the provided context.
+func (c *Client) GetDeviceRegistryEntryContext(ctx context.Context, deviceID string) (*DeviceRegistryEntry, error) {
+	devices, err := c.GetDeviceRegistryEntriesContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range devices {
+		if devices[i].ID == deviceID {
+			return &devices[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("device %s: %w", deviceID, ErrNotFound)
+}
+
+// UpdateDeviceRegistryEntry applies changes to a device registry entry and
+// returns the updated entry. Fields not present in changes are left
+// untouched; a nil value resets a field to its default.
+func (c *Client) UpdateDeviceRegistryEntry(deviceID string, changes map[string]interface{}) (*DeviceRegistryEntry, error) {
+	return c.UpdateDeviceRegistryEntryContext(context.Background(), deviceID, changes)
+}
+
+// UpdateDeviceRegistryEntryContext is like UpdateDeviceRegistryEntry but uses the provided context.
+func (c *Client) UpdateDeviceRegistryEntryContext(ctx context.Context, deviceID string, changes map[string]interface{}) (*DeviceRegistryEntry, error) {
+	payload := make(map[string]interface{}, len(changes)+1)
+	for k, v := range changes {
+		payload[k] = v
+	}
+	payload["device_id"] = deviceID
+
+	var updated DeviceRegistryEntry
+	if err := c.registryCommand(ctx, "device", "update", payload, &updated); err != nil {
+		return nil, fmt.Errorf("failed to update device %s: %w", deviceID, err)
+	}
+
+	return &updated, nil
+}
+
+// registryCommand sends a command to one of the config registries, e.g.
+// registryCommand(ctx, "area", "list", nil, &areas) sends config/area_registry/list.
+func (c *Client) registryCommand(ctx context.Context, registry, command string, payload map[string]interface{}, out interface{}) error {
+	ws, err := c.WebSocketContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	return ws.CommandContext(ctx, fmt.Sprintf("config/%s_registry/%s", registry, command), payload, out)
+}
+
+// NullableString returns nil for an empty string, so that registry updates
+// clear the field instead of setting it to an empty value.
+func NullableString(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+// withoutNulls removes the null fields of a payload. Create commands take
+// optional fields as plain values, so unset ones are left out instead of being
+// sent as null to clear them as on update.
+func withoutNulls(payload map[string]interface{}) map[string]interface{} {
+	for key, value := range payload {
+		if value == nil {
+			delete(payload, key)
+		}
+	}
+	return payload
+}
+
+// nonNilStrings returns an empty slice instead of nil, so that lists are
+// sent as [] rather than null.
+func nonNilStrings(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
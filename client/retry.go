@@ -0,0 +1,149 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"math/rand"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how failed requests are retried.
+// The zero value disables retries.
+type RetryPolicy struct {
+	// MaxRetries is the number of retries after the first attempt.
+	MaxRetries int
+
+	// WaitMin and WaitMax bound the exponential backoff between attempts.
+	WaitMin time.Duration
+	WaitMax time.Duration
+
+	// RetryStatusCodes lists the HTTP status codes that are retried.
+	RetryStatusCodes []int
+
+	// RetryMethods lists the HTTP methods whose requests may be replayed after
+	// a response or a failure mid-request. Other methods are only retried when
+	// the connection could not be established, or when marked with WithRetrySafe.
+	RetryMethods []string
+}
+
+// DefaultRetryPolicy returns the policy used by New when none is configured.
+// It retries idempotent requests that fail while Home Assistant or a reverse
+// proxy in front of it is restarting.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries: 3,
+		WaitMin:    500 * time.Millisecond,
+		WaitMax:    30 * time.Second,
+		RetryStatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+		RetryMethods: []string{"GET", "HEAD", "OPTIONS", "PUT", "DELETE"},
+	}
+}
+
+type retrySafeKey struct{}
+
+// WithRetrySafe marks requests made with the returned context as safe to
+// replay regardless of their method, e.g. a service call that only turns a
+// light on.
+func WithRetrySafe(ctx context.Context) context.Context {
+	return context.WithValue(ctx, retrySafeKey{}, true)
+}
+
+func isRetrySafe(ctx context.Context) bool {
+	safe, _ := ctx.Value(retrySafeKey{}).(bool)
+	return safe
+}
+
+// retryable reports whether another attempt should be made after a request
+// failed with err, or completed with the status code of resp.
+func (p RetryPolicy) retryable(ctx context.Context, method string, resp *http.Response, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	replayable := isRetrySafe(ctx) || slices.Contains(p.RetryMethods, method)
+
+	if err != nil {
+		// Certificate problems will not resolve themselves between attempts
+		if isTLSError(err) {
+			return false
+		}
+		// A failed dial means the request never reached the server
+		if isDialError(err) {
+			return true
+		}
+		return replayable
+	}
+
+	return replayable && slices.Contains(p.RetryStatusCodes, resp.StatusCode)
+}
+
+// backoff returns the wait before the given retry (starting at 1): an
+// exponentially growing delay capped at WaitMax, with up to half of it
+// randomized so that concurrent clients do not retry in lockstep.
+// A Retry-After header on resp takes precedence when present.
+func (p RetryPolicy) backoff(retry int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+			wait := time.Duration(seconds) * time.Second
+			if p.WaitMax > 0 && wait > p.WaitMax {
+				wait = p.WaitMax
+			}
+			return wait
+		}
+	}
+
+	wait := p.WaitMin
+	for i := 1; i < retry && (p.WaitMax == 0 || wait < p.WaitMax); i++ {
+		wait *= 2
+	}
+	if p.WaitMax > 0 && wait > p.WaitMax {
+		wait = p.WaitMax
+	}
+	if wait <= 0 {
+		return 0
+	}
+
+	half := wait / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// sleepContext waits for d, returning early with the context's error if it is cancelled.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// isDialError reports whether err occurred while establishing the connection.
+func isDialError(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// isTLSError reports whether err is a certificate verification failure or
+// a TLS alert sent by the server, such as a rejected client certificate.
+func isTLSError(err error) bool {
+	var verifyErr *tls.CertificateVerificationError
+	if errors.As(err, &verifyErr) {
+		return true
+	}
+
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "remote error"
+}
@@ -0,0 +1,184 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// testRetryPolicy returns the default policy with waits short enough for tests
+func testRetryPolicy() RetryPolicy {
+	policy := DefaultRetryPolicy()
+	policy.WaitMin = time.Millisecond
+	policy.WaitMax = 5 * time.Millisecond
+	return policy
+}
+
+// flakyServer fails the first failures requests with status, then succeeds.
+func flakyServer(failures int32, status int, attempts *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(attempts, 1) <= failures {
+			w.WriteHeader(status)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[]`))
+	}))
+}
+
+func TestClient_RetriesIdempotentRequests(t *testing.T) {
+	var attempts int32
+	server := flakyServer(2, http.StatusBadGateway, &attempts)
+	defer server.Close()
+
+	client := createTestClient(server)
+	client.Retry = testRetryPolicy()
+
+	if _, err := client.GetStates(); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if attempts != 3 {
+		t.Errorf("expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestClient_RetriesExhausted(t *testing.T) {
+	var attempts int32
+	server := flakyServer(100, http.StatusServiceUnavailable, &attempts)
+	defer server.Close()
+
+	client := createTestClient(server)
+	client.Retry = testRetryPolicy()
+	client.Retry.MaxRetries = 2
+
+	_, err := client.GetStates()
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("expected APIError with status 503, got %v", err)
+	}
+	if attempts != 3 {
+		t.Errorf("expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestClient_DoesNotRetryUnlistedStatus(t *testing.T) {
+	var attempts int32
+	server := flakyServer(100, http.StatusInternalServerError, &attempts)
+	defer server.Close()
+
+	client := createTestClient(server)
+	client.Retry = testRetryPolicy()
+
+	if _, err := client.GetStates(); err == nil {
+		t.Fatal("expected error for 500 response")
+	}
+	if attempts != 1 {
+		t.Errorf("expected 1 attempt, got %d", attempts)
+	}
+}
+
+func TestClient_DoesNotReplayServiceCalls(t *testing.T) {
+	var attempts int32
+	server := flakyServer(1, http.StatusBadGateway, &attempts)
+	defer server.Close()
+
+	client := createTestClient(server)
+	client.Retry = testRetryPolicy()
+
+	if _, err := client.CallService("script", "notify_everyone", nil); err == nil {
+		t.Fatal("expected error for 502 response")
+	}
+	if attempts != 1 {
+		t.Errorf("expected 1 attempt, got %d", attempts)
+	}
+}
+
+func TestClient_ReplaysServiceCallsMarkedSafe(t *testing.T) {
+	var attempts int32
+	server := flakyServer(1, http.StatusBadGateway, &attempts)
+	defer server.Close()
+
+	client := createTestClient(server)
+	client.Retry = testRetryPolicy()
+
+	ctx := WithRetrySafe(context.Background())
+	if _, err := client.CallServiceContext(ctx, "light", "turn_on", nil); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if attempts != 2 {
+		t.Errorf("expected 2 attempts, got %d", attempts)
+	}
+}
+
+func TestClient_RetryHonorsContext(t *testing.T) {
+	var attempts int32
+	server := flakyServer(100, http.StatusBadGateway, &attempts)
+	defer server.Close()
+
+	client := createTestClient(server)
+	client.Retry = testRetryPolicy()
+	client.Retry.WaitMin = time.Hour
+	client.Retry.WaitMax = time.Hour
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := client.GetStatesContext(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestRetryPolicy_RetryableDialErrors(t *testing.T) {
+	policy := DefaultRetryPolicy()
+	dialErr := &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+	readErr := &net.OpError{Op: "read", Net: "tcp", Err: errors.New("connection reset by peer")}
+
+	ctx := context.Background()
+
+	if !policy.retryable(ctx, "POST", nil, dialErr) {
+		t.Error("expected POST to be retried when the connection was refused")
+	}
+	if policy.retryable(ctx, "POST", nil, readErr) {
+		t.Error("expected POST not to be retried after the request was sent")
+	}
+	if !policy.retryable(ctx, "GET", nil, readErr) {
+		t.Error("expected GET to be retried after a connection reset")
+	}
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := RetryPolicy{WaitMin: 100 * time.Millisecond, WaitMax: time.Second}
+
+	for retry := 1; retry <= 10; retry++ {
+		base := policy.WaitMin << (retry - 1)
+		if base > policy.WaitMax || base <= 0 {
+			base = policy.WaitMax
+		}
+
+		wait := policy.backoff(retry, nil)
+		if wait < base/2 || wait > base {
+			t.Errorf("retry %d: expected wait in [%s, %s], got %s", retry, base/2, base, wait)
+		}
+	}
+}
+
+func TestRetryPolicy_BackoffRetryAfter(t *testing.T) {
+	policy := RetryPolicy{WaitMin: 100 * time.Millisecond, WaitMax: 10 * time.Second}
+
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"2"}}}
+	if wait := policy.backoff(1, resp); wait != 2*time.Second {
+		t.Errorf("expected Retry-After of 2s to be honored, got %s", wait)
+	}
+
+	resp.Header.Set("Retry-After", "3600")
+	if wait := policy.backoff(1, resp); wait != policy.WaitMax {
+		t.Errorf("expected Retry-After to be capped at %s, got %s", policy.WaitMax, wait)
+	}
+}
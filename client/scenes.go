@@ -0,0 +1,55 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// GetSceneConfig retrieves the configuration of a scene by its id.
+func (c *Client) GetSceneConfig(id string) (map[string]interface{}, error) {
+	return c.GetSceneConfigContext(context.Background(), id)
+}
+
+// GetSceneConfigContext is like GetSceneConfig but uses the provided context.
+func (c *Client) GetSceneConfigContext(ctx context.Context, id string) (map[string]interface{}, error) {
+	var config map[string]interface{}
+	if err := c.GetConfigItemContext(ctx, "scene", id, &config); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+// SaveSceneConfig creates or replaces the scene with the given id.
+func (c *Client) SaveSceneConfig(id string, config map[string]interface{}) error {
+	return c.SaveSceneConfigContext(context.Background(), id, config)
+}
+
+// SaveSceneConfigContext is like SaveSceneConfig but uses the provided context.
+func (c *Client) SaveSceneConfigContext(ctx context.Context, id string, config map[string]interface{}) error {
+	return c.SaveConfigItemContext(ctx, "scene", id, config)
+}
+
+// DeleteSceneConfig deletes the scene with the given id.
+func (c *Client) DeleteSceneConfig(id string) error {
+	return c.DeleteSceneConfigContext(context.Background(), id)
+}
+
+// DeleteSceneConfigContext is like DeleteSceneConfig but uses the provided context.
+func (c *Client) DeleteSceneConfigContext(ctx context.Context, id string) error {
+	return c.DeleteConfigItemContext(ctx, "scene", id)
+}
+
+// ReloadScenes reloads all scenes so that configuration changes take effect.
+func (c *Client) ReloadScenes() error {
+	return c.ReloadScenesContext(context.Background())
+}
+
+// ReloadScenesContext is like ReloadScenes but uses the provided context.
+func (c *Client) ReloadScenesContext(ctx context.Context) error {
+	if _, err := c.CallServiceContext(WithRetrySafe(ctx), "scene", "reload", nil); err != nil {
+		return fmt.Errorf("failed to reload scenes: %w", err)
+	}
+
+	return nil
+}
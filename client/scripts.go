@@ -0,0 +1,55 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// GetScriptConfig retrieves the configuration of a script by its object ID.
+func (c *Client) GetScriptConfig(objectID string) (map[string]interface{}, error) {
+	return c.GetScriptConfigContext(context.Background(), objectID)
+}
+
+// GetScriptConfigContext is like GetScriptConfig but uses the provided context.
+func (c *Client) GetScriptConfigContext(ctx context.Context, objectID string) (map[string]interface{}, error) {
+	var config map[string]interface{}
+	if err := c.GetConfigItemContext(ctx, "script", objectID, &config); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+// SaveScriptConfig creates or replaces the script with the given object ID.
+func (c *Client) SaveScriptConfig(objectID string, config map[string]interface{}) error {
+	return c.SaveScriptConfigContext(context.Background(), objectID, config)
+}
+
+// SaveScriptConfigContext is like SaveScriptConfig but uses the provided context.
+func (c *Client) SaveScriptConfigContext(ctx context.Context, objectID string, config map[string]interface{}) error {
+	return c.SaveConfigItemContext(ctx, "script", objectID, config)
+}
+
+// DeleteScriptConfig deletes the script with the given object ID.
+func (c *Client) DeleteScriptConfig(objectID string) error {
+	return c.DeleteScriptConfigContext(context.Background(), objectID)
+}
+
+// DeleteScriptConfigContext is like DeleteScriptConfig but uses the provided context.
+func (c *Client) DeleteScriptConfigContext(ctx context.Context, objectID string) error {
+	return c.DeleteConfigItemContext(ctx, "script", objectID)
+}
+
+// ReloadScripts reloads all scripts so that configuration changes take effect.
+func (c *Client) ReloadScripts() error {
+	return c.ReloadScriptsContext(context.Background())
+}
+
+// ReloadScriptsContext is like ReloadScripts but uses the provided context.
+func (c *Client) ReloadScriptsContext(ctx context.Context) error {
+	if _, err := c.CallServiceContext(WithRetrySafe(ctx), "script", "reload", nil); err != nil {
+		return fmt.Errorf("failed to reload scripts: %w", err)
+	}
+
+	return nil
+}
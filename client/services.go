@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -8,7 +9,12 @@ import (
 
 // GetServices retrieves all available services grouped by domain.
 func (c *Client) GetServices() ([]ServiceDomain, error) {
-	body, err := c.doRequest("GET", "/services", nil)
+	return c.GetServicesContext(context.Background())
+}
+
+// GetServicesContext is like GetServices but uses the provided context.
+func (c *Client) GetServicesContext(ctx context.Context) ([]ServiceDomain, error) {
+	body, err := c.doRequest(ctx, "GET", "/services", nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get services: %w", err)
 	}
@@ -23,7 +29,14 @@ func (c *Client) GetServices() ([]ServiceDomain, error) {
 
 // CallService calls a service in a specific domain.
 // The serviceData can contain entity_id and any additional service-specific parameters.
+// Service calls are not retried after reaching the server unless the context
+// is marked with WithRetrySafe.
 func (c *Client) CallService(domain, service string, serviceData map[string]interface{}) ([]State, error) {
+	return c.CallServiceContext(context.Background(), domain, service, serviceData)
+}
+
+// CallServiceContext is like CallService but uses the provided context.
+func (c *Client) CallServiceContext(ctx context.Context, domain, service string, serviceData map[string]interface{}) ([]State, error) {
 	var payload []byte
 	var err error
 
@@ -35,7 +48,7 @@ func (c *Client) CallService(domain, service string, serviceData map[string]inte
 	}
 
 	endpoint := fmt.Sprintf("/services/%s/%s", url.PathEscape(domain), url.PathEscape(service))
-	body, err := c.doRequest("POST", endpoint, payload)
+	body, err := c.doRequest(ctx, "POST", endpoint, payload)
 	if err != nil {
 		return nil, fmt.Errorf("failed to call service %s.%s: %w", domain, service, err)
 	}
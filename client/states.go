@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -8,7 +9,12 @@ import (
 
 // GetStates retrieves the state of all entities.
 func (c *Client) GetStates() ([]State, error) {
-	body, err := c.doRequest("GET", "/states", nil)
+	return c.GetStatesContext(context.Background())
+}
+
+// GetStatesContext is like GetStates but uses the provided context.
+func (c *Client) GetStatesContext(ctx context.Context) ([]State, error) {
+	body, err := c.doRequest(ctx, "GET", "/states", nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get states: %w", err)
 	}
@@ -23,8 +29,13 @@ func (c *Client) GetStates() ([]State, error) {
 
 // GetState retrieves the state of a specific entity.
 func (c *Client) GetState(entityID string) (*State, error) {
+	return c.GetStateContext(context.Background(), entityID)
+}
+
+// GetStateContext is like GetState but uses the provided context.
+func (c *Client) GetStateContext(ctx context.Context, entityID string) (*State, error) {
 	endpoint := fmt.Sprintf("/states/%s", url.PathEscape(entityID))
-	body, err := c.doRequest("GET", endpoint, nil)
+	body, err := c.doRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get state for %s: %w", entityID, err)
 	}
@@ -40,13 +51,19 @@ func (c *Client) GetState(entityID string) (*State, error) {
 // SetState updates the state of an entity.
 // This creates the entity if it doesn't exist.
 func (c *Client) SetState(entityID string, req StateUpdateRequest) (*State, error) {
+	return c.SetStateContext(context.Background(), entityID, req)
+}
+
+// SetStateContext is like SetState but uses the provided context.
+func (c *Client) SetStateContext(ctx context.Context, entityID string, req StateUpdateRequest) (*State, error) {
 	payload, err := json.Marshal(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal state update request: %w", err)
 	}
 
+	// Setting a state is idempotent, so the POST may be replayed
 	endpoint := fmt.Sprintf("/states/%s", url.PathEscape(entityID))
-	body, err := c.doRequest("POST", endpoint, payload)
+	body, err := c.doRequest(WithRetrySafe(ctx), "POST", endpoint, payload)
 	if err != nil {
 		return nil, fmt.Errorf("failed to set state for %s: %w", entityID, err)
 	}
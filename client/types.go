@@ -19,37 +19,37 @@ type Context struct {
 
 // Config represents the Home Assistant configuration.
 type Config struct {
-	Latitude        float64  `json:"latitude"`
-	Longitude       float64  `json:"longitude"`
-	Elevation       int      `json:"elevation"`
-	UnitSystem      UnitSystem `json:"unit_system"`
-	LocationName    string   `json:"location_name"`
-	TimeZone        string   `json:"time_zone"`
-	Components      []string `json:"components"`
-	ConfigDir       string   `json:"config_dir"`
-	WhitelistExternalDirs []string `json:"whitelist_external_dirs"`
-	AllowlistExternalDirs []string `json:"allowlist_external_dirs"`
-	AllowlistExternalURLs []string `json:"allowlist_external_urls"`
-	Version         string   `json:"version"`
-	ConfigSource    string   `json:"config_source"`
-	SafeMode        bool     `json:"safe_mode"`
-	State           string   `json:"state"`
-	ExternalURL     string   `json:"external_url,omitempty"`
-	InternalURL     string   `json:"internal_url,omitempty"`
-	Currency        string   `json:"currency"`
-	Country         string   `json:"country,omitempty"`
-	Language        string   `json:"language"`
+	Latitude              float64    `json:"latitude"`
+	Longitude             float64    `json:"longitude"`
+	Elevation             int        `json:"elevation"`
+	UnitSystem            UnitSystem `json:"unit_system"`
+	LocationName          string     `json:"location_name"`
+	TimeZone              string     `json:"time_zone"`
+	Components            []string   `json:"components"`
+	ConfigDir             string     `json:"config_dir"`
+	WhitelistExternalDirs []string   `json:"whitelist_external_dirs"`
+	AllowlistExternalDirs []string   `json:"allowlist_external_dirs"`
+	AllowlistExternalURLs []string   `json:"allowlist_external_urls"`
+	Version               string     `json:"version"`
+	ConfigSource          string     `json:"config_source"`
+	SafeMode              bool       `json:"safe_mode"`
+	State                 string     `json:"state"`
+	ExternalURL           string     `json:"external_url,omitempty"`
+	InternalURL           string     `json:"internal_url,omitempty"`
+	Currency              string     `json:"currency"`
+	Country               string     `json:"country,omitempty"`
+	Language              string     `json:"language"`
 }
 
 // UnitSystem represents the unit system configuration.
 type UnitSystem struct {
-	Length            string `json:"length"`
+	Length                   string `json:"length"`
 	AccumulatedPrecipitation string `json:"accumulated_precipitation"`
-	Mass              string `json:"mass"`
-	Pressure          string `json:"pressure"`
-	Temperature       string `json:"temperature"`
-	Volume            string `json:"volume"`
-	WindSpeed         string `json:"wind_speed"`
+	Mass                     string `json:"mass"`
+	Pressure                 string `json:"pressure"`
+	Temperature              string `json:"temperature"`
+	Volume                   string `json:"volume"`
+	WindSpeed                string `json:"wind_speed"`
 }
 
 // ServiceDomain represents a domain with its available services.
@@ -60,10 +60,10 @@ type ServiceDomain struct {
 
 // ServiceDef represents the definition of a service.
 type ServiceDef struct {
-	Name        string                 `json:"name,omitempty"`
-	Description string                 `json:"description,omitempty"`
+	Name        string                  `json:"name,omitempty"`
+	Description string                  `json:"description,omitempty"`
 	Fields      map[string]ServiceField `json:"fields,omitempty"`
-	Target      *ServiceTarget         `json:"target,omitempty"`
+	Target      *ServiceTarget          `json:"target,omitempty"`
 }
 
 // ServiceField represents a field in a service definition.
@@ -118,3 +118,83 @@ type StateUpdateRequest struct {
 	State      string                 `json:"state"`
 	Attributes map[string]interface{} `json:"attributes,omitempty"`
 }
+
+// Zone represents an item in the zone storage collection.
+type Zone struct {
+	ID        string  `json:"id,omitempty"`
+	Name      string  `json:"name"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	Radius    float64 `json:"radius"`
+	Passive   bool    `json:"passive"`
+	Icon      string  `json:"icon,omitempty"`
+}
+
+// EntityRegistryEntry represents an entry in the entity registry.
+// Aliases are only returned by GetEntityRegistryEntry, not by the list.
+type EntityRegistryEntry struct {
+	ID           string                            `json:"id,omitempty"`
+	EntityID     string                            `json:"entity_id"`
+	UniqueID     string                            `json:"unique_id,omitempty"`
+	Platform     string                            `json:"platform,omitempty"`
+	DeviceID     string                            `json:"device_id,omitempty"`
+	AreaID       string                            `json:"area_id,omitempty"`
+	Labels       []string                          `json:"labels,omitempty"`
+	Name         string                            `json:"name,omitempty"`
+	OriginalName string                            `json:"original_name,omitempty"`
+	Icon         string                            `json:"icon,omitempty"`
+	Aliases      []string                          `json:"aliases,omitempty"`
+	HiddenBy     string                            `json:"hidden_by,omitempty"`
+	DisabledBy   string                            `json:"disabled_by,omitempty"`
+	Options      map[string]map[string]interface{} `json:"options,omitempty"`
+}
+
+// DeviceRegistryEntry represents an entry in the device registry.
+// Identifiers are (integration domain, ID) pairs and connections are
+// (type, value) pairs such as ("mac", "aa:bb:cc:dd:ee:ff").
+type DeviceRegistryEntry struct {
+	ID           string     `json:"id"`
+	Name         string     `json:"name,omitempty"`
+	NameByUser   string     `json:"name_by_user,omitempty"`
+	Manufacturer string     `json:"manufacturer,omitempty"`
+	Model        string     `json:"model,omitempty"`
+	ModelID      string     `json:"model_id,omitempty"`
+	SWVersion    string     `json:"sw_version,omitempty"`
+	HWVersion    string     `json:"hw_version,omitempty"`
+	SerialNumber string     `json:"serial_number,omitempty"`
+	AreaID       string     `json:"area_id,omitempty"`
+	Labels       []string   `json:"labels,omitempty"`
+	DisabledBy   string     `json:"disabled_by,omitempty"`
+	ViaDeviceID  string     `json:"via_device_id,omitempty"`
+	Identifiers  [][]string `json:"identifiers,omitempty"`
+	Connections  [][]string `json:"connections,omitempty"`
+}
+
+// Area represents an entry in the area registry.
+type Area struct {
+	AreaID  string   `json:"area_id"`
+	Name    string   `json:"name"`
+	Aliases []string `json:"aliases"`
+	FloorID string   `json:"floor_id,omitempty"`
+	Icon    string   `json:"icon,omitempty"`
+	Picture string   `json:"picture,omitempty"`
+	Labels  []string `json:"labels"`
+}
+
+// Floor represents an entry in the floor registry.
+type Floor struct {
+	FloorID string   `json:"floor_id"`
+	Name    string   `json:"name"`
+	Aliases []string `json:"aliases"`
+	Icon    string   `json:"icon,omitempty"`
+	Level   *int     `json:"level"`
+}
+
+// Label represents an entry in the label registry.
+type Label struct {
+	LabelID     string `json:"label_id"`
+	Name        string `json:"name"`
+	Color       string `json:"color,omitempty"`
+	Icon        string `json:"icon,omitempty"`
+	Description string `json:"description,omitempty"`
+}
@@ -0,0 +1,355 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/websocket"
+)
+
+// ErrWSClosed is returned for commands sent on, or pending on, a WebSocket
+// connection that has been closed.
+var ErrWSClosed = errors.New("websocket connection closed")
+
+// AuthError is returned when Home Assistant rejects the access token
+// during the WebSocket authentication handshake.
+type AuthError struct {
+	Message string
+}
+
+func (e *AuthError) Error() string {
+	return fmt.Sprintf("websocket authentication failed: %s", e.Message)
+}
+
+// WSError is returned when Home Assistant replies to a WebSocket command
+// with success set to false.
+type WSError struct {
+	Command string
+	Code    string
+	Message string
+}
+
+func (e *WSError) Error() string {
+	return fmt.Sprintf("websocket command %s failed: %s (%s)", e.Command, e.Message, e.Code)
+}
+
+// WSClient is a client for the Home Assistant WebSocket API.
+// Commands are multiplexed over a single connection and matched to
+// their result messages by message ID.
+type WSClient struct {
+	URL     string
+	Token   string
+	Timeout time.Duration
+
+	// TLSConfig is used when dialling wss:// endpoints.
+	TLSConfig *tls.Config
+
+	// HAVersion is the Home Assistant version reported during the handshake.
+	HAVersion string
+
+	conn    *websocket.Conn
+	writeMu sync.Mutex
+
+	mu      sync.Mutex
+	nextID  int
+	pending map[int]chan *wsMessage
+	err     error
+}
+
+// wsMessage is the envelope shared by all WebSocket messages.
+type wsMessage struct {
+	ID        int             `json:"id,omitempty"`
+	Type      string          `json:"type"`
+	Success   bool            `json:"success,omitempty"`
+	Result    json.RawMessage `json:"result,omitempty"`
+	Error     *wsErrorBody    `json:"error,omitempty"`
+	Message   string          `json:"message,omitempty"`
+	HAVersion string          `json:"ha_version,omitempty"`
+}
+
+type wsErrorBody struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// NewWSClient creates a new, unconnected Home Assistant WebSocket client.
+// The wsURL must point at the websocket endpoint (e.g. ws://host:8123/api/websocket).
+func NewWSClient(wsURL, token string) *WSClient {
+	return &WSClient{
+		URL:     wsURL,
+		Token:   token,
+		Timeout: 30 * time.Second,
+	}
+}
+
+// websocketURL derives the WebSocket endpoint from a REST API base URL.
+func websocketURL(baseURL string) (string, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid base URL %q: %w", baseURL, err)
+	}
+
+	switch u.Scheme {
+	case "http":
+		u.Scheme = "ws"
+	case "https":
+		u.Scheme = "wss"
+	default:
+		return "", fmt.Errorf("unsupported URL scheme %q", u.Scheme)
+	}
+
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/websocket"
+
+	return u.String(), nil
+}
+
+// Connect dials the WebSocket endpoint and performs the auth handshake.
+func (w *WSClient) Connect() error {
+	return w.ConnectContext(context.Background())
+}
+
+// ConnectContext is like Connect but aborts the dial and handshake
+// if ctx is cancelled.
+func (w *WSClient) ConnectContext(ctx context.Context) error {
+	origin, err := url.Parse(w.URL)
+	if err != nil {
+		return fmt.Errorf("invalid websocket URL %q: %w", w.URL, err)
+	}
+	origin.Scheme = strings.Replace(origin.Scheme, "ws", "http", 1)
+	origin.Path = "/"
+
+	config, err := websocket.NewConfig(w.URL, origin.String())
+	if err != nil {
+		return fmt.Errorf("failed to create websocket config: %w", err)
+	}
+	config.TlsConfig = w.TLSConfig
+
+	conn, err := config.DialContext(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to connect to websocket: %w", err)
+	}
+
+	// Unblock the handshake reads if ctx is cancelled
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	err = w.authenticate(conn)
+	if !stop() {
+		err = fmt.Errorf("websocket authentication aborted: %w", ctx.Err())
+	}
+	if err != nil {
+		conn.Close()
+		return err
+	}
+
+	w.mu.Lock()
+	w.conn = conn
+	w.pending = make(map[int]chan *wsMessage)
+	w.err = nil
+	w.mu.Unlock()
+
+	go w.readLoop()
+
+	return nil
+}
+
+// authenticate runs the auth_required / auth / auth_ok exchange.
+func (w *WSClient) authenticate(conn *websocket.Conn) error {
+	if w.Timeout > 0 {
+		conn.SetDeadline(time.Now().Add(w.Timeout))
+		defer conn.SetDeadline(time.Time{})
+	}
+
+	var msg wsMessage
+	if err := websocket.JSON.Receive(conn, &msg); err != nil {
+		return fmt.Errorf("failed to read auth_required message: %w", err)
+	}
+	if msg.Type != "auth_required" {
+		return fmt.Errorf("unexpected websocket message %q, expected auth_required", msg.Type)
+	}
+
+	auth := map[string]string{
+		"type":         "auth",
+		"access_token": w.Token,
+	}
+	if err := websocket.JSON.Send(conn, auth); err != nil {
+		return fmt.Errorf("failed to send auth message: %w", err)
+	}
+
+	msg = wsMessage{}
+	if err := websocket.JSON.Receive(conn, &msg); err != nil {
+		return fmt.Errorf("failed to read auth response: %w", err)
+	}
+
+	switch msg.Type {
+	case "auth_ok":
+		w.HAVersion = msg.HAVersion
+		return nil
+	case "auth_invalid":
+		return &AuthError{Message: msg.Message}
+	default:
+		return fmt.Errorf("unexpected websocket message %q during authentication", msg.Type)
+	}
+}
+
+// readLoop delivers result messages to the commands waiting on them.
+// It runs until the connection fails or is closed.
+func (w *WSClient) readLoop() {
+	for {
+		var msg wsMessage
+		if err := websocket.JSON.Receive(w.conn, &msg); err != nil {
+			w.shutdown(fmt.Errorf("%w: %v", ErrWSClosed, err))
+			return
+		}
+
+		switch msg.Type {
+		case "result":
+		case "pong":
+			// Replies to ping carry no success flag
+			msg.Success = true
+		default:
+			continue
+		}
+
+		w.mu.Lock()
+		ch, ok := w.pending[msg.ID]
+		delete(w.pending, msg.ID)
+		w.mu.Unlock()
+
+		if ok {
+			ch <- &msg
+		}
+	}
+}
+
+// shutdown records the terminal error and releases every pending command.
+func (w *WSClient) shutdown(err error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.err != nil {
+		return
+	}
+	w.err = err
+	for id, ch := range w.pending {
+		close(ch)
+		delete(w.pending, id)
+	}
+}
+
+// Close closes the underlying connection.
+func (w *WSClient) Close() error {
+	w.shutdown(ErrWSClosed)
+
+	w.mu.Lock()
+	conn := w.conn
+	w.mu.Unlock()
+
+	if conn == nil {
+		return nil
+	}
+	return conn.Close()
+}
+
+// Connected reports whether the client has an open, authenticated connection.
+func (w *WSClient) Connected() bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.conn != nil && w.err == nil
+}
+
+// Command sends a command of the given type and waits for its result.
+// Fields in payload are merged into the message alongside id and type.
+// If result is non-nil, the result field of the reply is decoded into it.
+func (w *WSClient) Command(msgType string, payload map[string]interface{}, result interface{}) error {
+	return w.CommandContext(context.Background(), msgType, payload, result)
+}
+
+// CommandContext is like Command but stops waiting for the result
+// if ctx is cancelled.
+func (w *WSClient) CommandContext(ctx context.Context, msgType string, payload map[string]interface{}, result interface{}) error {
+	w.mu.Lock()
+	if w.conn == nil {
+		w.mu.Unlock()
+		return ErrWSClosed
+	}
+	if w.err != nil {
+		err := w.err
+		w.mu.Unlock()
+		return err
+	}
+	w.nextID++
+	id := w.nextID
+	ch := make(chan *wsMessage, 1)
+	w.pending[id] = ch
+	w.mu.Unlock()
+
+	msg := make(map[string]interface{}, len(payload)+2)
+	for k, v := range payload {
+		msg[k] = v
+	}
+	msg["id"] = id
+	msg["type"] = msgType
+
+	w.writeMu.Lock()
+	err := websocket.JSON.Send(w.conn, msg)
+	w.writeMu.Unlock()
+	if err != nil {
+		w.forget(id)
+		return fmt.Errorf("failed to send websocket command %s: %w", msgType, err)
+	}
+
+	var timeout <-chan time.Time
+	if w.Timeout > 0 {
+		timer := time.NewTimer(w.Timeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	var reply *wsMessage
+	select {
+	case reply = <-ch:
+	case <-timeout:
+		w.forget(id)
+		return fmt.Errorf("websocket command %s timed out after %s", msgType, w.Timeout)
+	case <-ctx.Done():
+		w.forget(id)
+		return fmt.Errorf("websocket command %s aborted: %w", msgType, ctx.Err())
+	}
+
+	if reply == nil {
+		w.mu.Lock()
+		err := w.err
+		w.mu.Unlock()
+		return fmt.Errorf("websocket command %s aborted: %w", msgType, err)
+	}
+
+	if !reply.Success {
+		wsErr := &WSError{Command: msgType}
+		if reply.Error != nil {
+			wsErr.Code = reply.Error.Code
+			wsErr.Message = reply.Error.Message
+		}
+		return wsErr
+	}
+
+	if result != nil && len(reply.Result) > 0 {
+		if err := json.Unmarshal(reply.Result, result); err != nil {
+			return fmt.Errorf("failed to parse %s result: %w", msgType, err)
+		}
+	}
+
+	return nil
+}
+
+// forget drops a pending command that will no longer be waited on.
+func (w *WSClient) forget(id int) {
+	w.mu.Lock()
+	delete(w.pending, id)
+	w.mu.Unlock()
+}
@@ -0,0 +1,892 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/websocket"
+)
+
+// fakeWSHandler answers a single decoded command message.
+// It returns the result payload, or a non-nil error body for a failed command.
+type fakeWSHandler func(msg map[string]interface{}) (interface{}, *wsErrorBody)
+
+// newFakeWSServer starts an in-process WebSocket server that performs the
+// Home Assistant auth handshake and answers commands with handler.
+func newFakeWSServer(t *testing.T, handler fakeWSHandler) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(fakeWSServer(handler))
+}
+
+// fakeWSServer returns the http.Handler behind newFakeWSServer
+func fakeWSServer(handler fakeWSHandler) http.Handler {
+	return websocket.Server{Handler: func(conn *websocket.Conn) {
+		websocket.JSON.Send(conn, map[string]string{"type": "auth_required", "ha_version": "2024.6.0"})
+
+		var auth map[string]string
+		if err := websocket.JSON.Receive(conn, &auth); err != nil {
+			return
+		}
+		if auth["type"] != "auth" || auth["access_token"] != "test-token" {
+			websocket.JSON.Send(conn, map[string]string{"type": "auth_invalid", "message": "Invalid access token or password"})
+			return
+		}
+		websocket.JSON.Send(conn, map[string]string{"type": "auth_ok", "ha_version": "2024.6.0"})
+
+		for {
+			var msg map[string]interface{}
+			if err := websocket.JSON.Receive(conn, &msg); err != nil {
+				return
+			}
+
+			result, errBody := handler(msg)
+			reply := map[string]interface{}{
+				"id":      msg["id"],
+				"type":    "result",
+				"success": errBody == nil,
+			}
+			if errBody != nil {
+				reply["error"] = errBody
+			} else {
+				reply["result"] = result
+			}
+			websocket.JSON.Send(conn, reply)
+		}
+	}}
+}
+
+// createTestWSClient connects a WSClient to a fake server
+func createTestWSClient(t *testing.T, server *httptest.Server) *WSClient {
+	t.Helper()
+
+	wsURL, err := websocketURL(server.URL)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	ws := NewWSClient(wsURL, "test-token")
+	if err := ws.Connect(); err != nil {
+		t.Fatalf("expected no error connecting, got %v", err)
+	}
+	t.Cleanup(func() { ws.Close() })
+
+	return ws
+}
+
+func TestWebsocketURL(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"http://localhost:8123/api", "ws://localhost:8123/api/websocket"},
+		{"https://ha.example.com/api/", "wss://ha.example.com/api/websocket"},
+		{"http://127.0.0.1:1234", "ws://127.0.0.1:1234/websocket"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := websocketURL(tt.input)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("websocketURL(%q) = %q, expected %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+
+	if _, err := websocketURL("ftp://localhost"); err == nil {
+		t.Error("expected error for unsupported scheme")
+	}
+}
+
+func TestWSClient_Connect(t *testing.T) {
+	server := newFakeWSServer(t, func(msg map[string]interface{}) (interface{}, *wsErrorBody) {
+		return nil, nil
+	})
+	defer server.Close()
+
+	ws := createTestWSClient(t, server)
+
+	if !ws.Connected() {
+		t.Error("expected client to be connected")
+	}
+	if ws.HAVersion != "2024.6.0" {
+		t.Errorf("expected HAVersion '2024.6.0', got %s", ws.HAVersion)
+	}
+}
+
+func TestWSClient_AuthInvalid(t *testing.T) {
+	server := newFakeWSServer(t, func(msg map[string]interface{}) (interface{}, *wsErrorBody) {
+		return nil, nil
+	})
+	defer server.Close()
+
+	wsURL, _ := websocketURL(server.URL)
+	ws := NewWSClient(wsURL, "wrong-token")
+	err := ws.Connect()
+	if err == nil {
+		t.Fatal("expected error for invalid token")
+	}
+
+	var authErr *AuthError
+	if !errors.As(err, &authErr) {
+		t.Fatalf("expected AuthError, got %T: %v", err, err)
+	}
+	if ws.Connected() {
+		t.Error("expected client to not be connected")
+	}
+}
+
+func TestWSClient_Command(t *testing.T) {
+	server := newFakeWSServer(t, func(msg map[string]interface{}) (interface{}, *wsErrorBody) {
+		if msg["type"] != "zone/list" {
+			t.Errorf("expected type 'zone/list', got %v", msg["type"])
+		}
+		return []map[string]interface{}{
+			{"id": "office", "name": "Office"},
+		}, nil
+	})
+	defer server.Close()
+
+	ws := createTestWSClient(t, server)
+
+	var zones []map[string]interface{}
+	if err := ws.Command("zone/list", nil, &zones); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(zones) != 1 {
+		t.Fatalf("expected 1 zone, got %d", len(zones))
+	}
+	if zones[0]["name"] != "Office" {
+		t.Errorf("expected name 'Office', got %v", zones[0]["name"])
+	}
+}
+
+func TestWSClient_CommandPayload(t *testing.T) {
+	server := newFakeWSServer(t, func(msg map[string]interface{}) (interface{}, *wsErrorBody) {
+		if msg["name"] != "Office" {
+			t.Errorf("expected name 'Office', got %v", msg["name"])
+		}
+		if _, ok := msg["id"].(float64); !ok {
+			t.Errorf("expected numeric id, got %v", msg["id"])
+		}
+		return msg, nil
+	})
+	defer server.Close()
+
+	ws := createTestWSClient(t, server)
+
+	// A payload "type" must not override the command type
+	var echo map[string]interface{}
+	err := ws.Command("zone/create", map[string]interface{}{"name": "Office", "type": "bogus"}, &echo)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if echo["type"] != "zone/create" {
+		t.Errorf("expected type 'zone/create', got %v", echo["type"])
+	}
+}
+
+func TestWSClient_CommandError(t *testing.T) {
+	server := newFakeWSServer(t, func(msg map[string]interface{}) (interface{}, *wsErrorBody) {
+		return nil, &wsErrorBody{Code: "not_found", Message: "Unable to find zone_id office"}
+	})
+	defer server.Close()
+
+	ws := createTestWSClient(t, server)
+
+	err := ws.Command("zone/delete", map[string]interface{}{"zone_id": "office"}, nil)
+	if err == nil {
+		t.Fatal("expected error for failed command")
+	}
+
+	var wsErr *WSError
+	if !errors.As(err, &wsErr) {
+		t.Fatalf("expected WSError, got %T: %v", err, err)
+	}
+	if wsErr.Code != "not_found" {
+		t.Errorf("expected code 'not_found', got %s", wsErr.Code)
+	}
+	if wsErr.Command != "zone/delete" {
+		t.Errorf("expected command 'zone/delete', got %s", wsErr.Command)
+	}
+}
+
+func TestWSClient_ConcurrentCommands(t *testing.T) {
+	server := newFakeWSServer(t, func(msg map[string]interface{}) (interface{}, *wsErrorBody) {
+		return msg["value"], nil
+	})
+	defer server.Close()
+
+	ws := createTestWSClient(t, server)
+
+	const n = 20
+	errs := make(chan error, n)
+	for i := 0; i < n; i++ {
+		go func(i int) {
+			var got int
+			if err := ws.Command("echo", map[string]interface{}{"value": i}, &got); err != nil {
+				errs <- err
+				return
+			}
+			if got != i {
+				errs <- errors.New("reply was correlated with the wrong command")
+				return
+			}
+			errs <- nil
+		}(i)
+	}
+
+	for i := 0; i < n; i++ {
+		if err := <-errs; err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestWSClient_CommandContextCancelled(t *testing.T) {
+	release := make(chan struct{})
+	server := newFakeWSServer(t, func(msg map[string]interface{}) (interface{}, *wsErrorBody) {
+		<-release
+		return nil, nil
+	})
+	defer server.Close()
+	defer close(release)
+
+	ws := createTestWSClient(t, server)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err := ws.CommandContext(ctx, "zone/list", nil, nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestWSClient_CommandAfterClose(t *testing.T) {
+	server := newFakeWSServer(t, func(msg map[string]interface{}) (interface{}, *wsErrorBody) {
+		return nil, nil
+	})
+	defer server.Close()
+
+	ws := createTestWSClient(t, server)
+	ws.Close()
+
+	err := ws.Command("zone/list", nil, nil)
+	if !errors.Is(err, ErrWSClosed) {
+		t.Errorf("expected ErrWSClosed, got %v", err)
+	}
+}
+
+func TestClient_WebSocket(t *testing.T) {
+	server := newFakeWSServer(t, func(msg map[string]interface{}) (interface{}, *wsErrorBody) {
+		raw, _ := json.Marshal(msg)
+		return strings.Contains(string(raw), "get_config"), nil
+	})
+	defer server.Close()
+
+	client := createTestClient(server)
+
+	ws, err := client.WebSocket()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	defer ws.Close()
+
+	again, err := client.WebSocket()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if again != ws {
+		t.Error("expected the connection to be reused")
+	}
+
+	var ok bool
+	if err := ws.Command("get_config", nil, &ok); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !ok {
+		t.Error("expected command to be echoed")
+	}
+}
+
+func TestClient_GetZone(t *testing.T) {
+	server := newFakeWSServer(t, func(msg map[string]interface{}) (interface{}, *wsErrorBody) {
+		if msg["type"] != "zone/list" {
+			t.Errorf("expected type 'zone/list', got %v", msg["type"])
+		}
+		return []Zone{
+			{ID: "office", Name: "Office", Latitude: 51.5, Longitude: -0.12, Radius: 50},
+		}, nil
+	})
+	defer server.Close()
+
+	client := createTestClient(server)
+
+	zone, err := client.GetZone("office")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if zone.Name != "Office" {
+		t.Errorf("expected name 'Office', got %s", zone.Name)
+	}
+	if zone.Radius != 50 {
+		t.Errorf("expected radius 50, got %f", zone.Radius)
+	}
+
+	_, err = client.GetZone("missing")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestClient_CreateZone(t *testing.T) {
+	server := newFakeWSServer(t, func(msg map[string]interface{}) (interface{}, *wsErrorBody) {
+		if msg["type"] != "zone/create" {
+			t.Errorf("expected type 'zone/create', got %v", msg["type"])
+		}
+		if msg["name"] != "Office" {
+			t.Errorf("expected name 'Office', got %v", msg["name"])
+		}
+		if _, ok := msg["zone_id"]; ok {
+			t.Error("expected no zone_id in create payload")
+		}
+		return Zone{ID: "office", Name: "Office", Latitude: 51.5, Longitude: -0.12, Radius: 100}, nil
+	})
+	defer server.Close()
+
+	client := createTestClient(server)
+
+	zone, err := client.CreateZone(Zone{Name: "Office", Latitude: 51.5, Longitude: -0.12, Radius: 100})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if zone.ID != "office" {
+		t.Errorf("expected id 'office', got %s", zone.ID)
+	}
+}
+
+func TestClient_UpdateZone(t *testing.T) {
+	server := newFakeWSServer(t, func(msg map[string]interface{}) (interface{}, *wsErrorBody) {
+		if msg["type"] != "zone/update" {
+			t.Errorf("expected type 'zone/update', got %v", msg["type"])
+		}
+		if msg["zone_id"] != "office" {
+			t.Errorf("expected zone_id 'office', got %v", msg["zone_id"])
+		}
+		if _, ok := msg["id"].(string); ok {
+			t.Error("expected collection id to be sent as zone_id only")
+		}
+		return Zone{ID: "office", Name: "Office", Radius: 75}, nil
+	})
+	defer server.Close()
+
+	client := createTestClient(server)
+
+	zone, err := client.UpdateZone("office", Zone{ID: "office", Name: "Office", Radius: 75})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if zone.Radius != 75 {
+		t.Errorf("expected radius 75, got %f", zone.Radius)
+	}
+}
+
+func TestClient_DeleteZone(t *testing.T) {
+	server := newFakeWSServer(t, func(msg map[string]interface{}) (interface{}, *wsErrorBody) {
+		if msg["type"] != "zone/delete" {
+			t.Errorf("expected type 'zone/delete', got %v", msg["type"])
+		}
+		if msg["zone_id"] != "office" {
+			t.Errorf("expected zone_id 'office', got %v", msg["zone_id"])
+		}
+		return nil, nil
+	})
+	defer server.Close()
+
+	client := createTestClient(server)
+
+	if err := client.DeleteZone("office"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func TestClient_GetArea(t *testing.T) {
+	server := newFakeWSServer(t, func(msg map[string]interface{}) (interface{}, *wsErrorBody) {
+		if msg["type"] != "config/area_registry/list" {
+			t.Errorf("expected type 'config/area_registry/list', got %v", msg["type"])
+		}
+		return []map[string]interface{}{
+			{"area_id": "kitchen", "name": "Kitchen", "aliases": []string{"cooking"}, "floor_id": "ground", "icon": nil, "picture": nil, "labels": []string{}},
+		}, nil
+	})
+	defer server.Close()
+
+	client := createTestClient(server)
+
+	area, err := client.GetArea("kitchen")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if area.Name != "Kitchen" || area.FloorID != "ground" {
+		t.Errorf("unexpected area: %+v", area)
+	}
+	if area.Icon != "" {
+		t.Errorf("expected null icon to decode as empty, got %q", area.Icon)
+	}
+
+	_, err = client.GetArea("missing")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestClient_CreateArea(t *testing.T) {
+	server := newFakeWSServer(t, func(msg map[string]interface{}) (interface{}, *wsErrorBody) {
+		if msg["type"] != "config/area_registry/create" {
+			t.Errorf("expected type 'config/area_registry/create', got %v", msg["type"])
+		}
+		if msg["name"] != "Kitchen" {
+			t.Errorf("expected name 'Kitchen', got %v", msg["name"])
+		}
+		if aliases, ok := msg["aliases"].([]interface{}); !ok || len(aliases) != 0 {
+			t.Errorf("expected empty aliases list, got %v", msg["aliases"])
+		}
+		for _, key := range []string{"floor_id", "icon", "picture"} {
+			if v, ok := msg[key]; ok {
+				t.Errorf("expected no %s, got %v", key, v)
+			}
+		}
+		return Area{AreaID: "kitchen", Name: "Kitchen"}, nil
+	})
+	defer server.Close()
+
+	client := createTestClient(server)
+
+	area, err := client.CreateArea(Area{Name: "Kitchen"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if area.AreaID != "kitchen" {
+		t.Errorf("expected area_id 'kitchen', got %s", area.AreaID)
+	}
+}
+
+func TestClient_UpdateArea(t *testing.T) {
+	server := newFakeWSServer(t, func(msg map[string]interface{}) (interface{}, *wsErrorBody) {
+		if msg["type"] != "config/area_registry/update" {
+			t.Errorf("expected type 'config/area_registry/update', got %v", msg["type"])
+		}
+		if msg["area_id"] != "kitchen" {
+			t.Errorf("expected area_id 'kitchen', got %v", msg["area_id"])
+		}
+		if msg["icon"] != "mdi:stove" {
+			t.Errorf("expected icon 'mdi:stove', got %v", msg["icon"])
+		}
+		return Area{AreaID: "kitchen", Name: "Kitchen", Icon: "mdi:stove"}, nil
+	})
+	defer server.Close()
+
+	client := createTestClient(server)
+
+	area, err := client.UpdateArea("kitchen", Area{Name: "Kitchen", Icon: "mdi:stove"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if area.Icon != "mdi:stove" {
+		t.Errorf("expected icon 'mdi:stove', got %s", area.Icon)
+	}
+}
+
+func TestClient_DeleteAreaNotFound(t *testing.T) {
+	server := newFakeWSServer(t, func(msg map[string]interface{}) (interface{}, *wsErrorBody) {
+		if msg["area_id"] != "missing" {
+			t.Errorf("expected area_id 'missing', got %v", msg["area_id"])
+		}
+		return nil, &wsErrorBody{Code: "not_found", Message: "Area ID doesn't exist"}
+	})
+	defer server.Close()
+
+	client := createTestClient(server)
+
+	err := client.DeleteArea("missing")
+	if !IsNotFound(err) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
+
+func TestClient_GetFloor(t *testing.T) {
+	server := newFakeWSServer(t, func(msg map[string]interface{}) (interface{}, *wsErrorBody) {
+		if msg["type"] != "config/floor_registry/list" {
+			t.Errorf("expected type 'config/floor_registry/list', got %v", msg["type"])
+		}
+		return []map[string]interface{}{
+			{"floor_id": "ground", "name": "Ground Floor", "aliases": []string{}, "icon": nil, "level": 0},
+			{"floor_id": "attic", "name": "Attic", "aliases": []string{}, "icon": nil, "level": nil},
+		}, nil
+	})
+	defer server.Close()
+
+	client := createTestClient(server)
+
+	floor, err := client.GetFloor("ground")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if floor.Level == nil || *floor.Level != 0 {
+		t.Errorf("expected level 0, got %v", floor.Level)
+	}
+
+	floor, err = client.GetFloor("attic")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if floor.Level != nil {
+		t.Errorf("expected no level, got %v", *floor.Level)
+	}
+
+	_, err = client.GetFloor("missing")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestClient_CreateFloor(t *testing.T) {
+	server := newFakeWSServer(t, func(msg map[string]interface{}) (interface{}, *wsErrorBody) {
+		if msg["type"] != "config/floor_registry/create" {
+			t.Errorf("expected type 'config/floor_registry/create', got %v", msg["type"])
+		}
+		if msg["level"] != float64(0) {
+			t.Errorf("expected level 0, got %v", msg["level"])
+		}
+		if v, ok := msg["icon"]; ok {
+			t.Errorf("expected no icon, got %v", v)
+		}
+		return map[string]interface{}{"floor_id": "ground", "name": "Ground", "level": 0}, nil
+	})
+	defer server.Close()
+
+	client := createTestClient(server)
+
+	level := 0
+	floor, err := client.CreateFloor(Floor{Name: "Ground", Level: &level})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if floor.FloorID != "ground" {
+		t.Errorf("expected floor_id 'ground', got %s", floor.FloorID)
+	}
+}
+
+func TestClient_UpdateFloor(t *testing.T) {
+	server := newFakeWSServer(t, func(msg map[string]interface{}) (interface{}, *wsErrorBody) {
+		if msg["type"] != "config/floor_registry/update" {
+			t.Errorf("expected type 'config/floor_registry/update', got %v", msg["type"])
+		}
+		if msg["floor_id"] != "attic" {
+			t.Errorf("expected floor_id 'attic', got %v", msg["floor_id"])
+		}
+		if v, ok := msg["level"]; !ok || v != nil {
+			t.Errorf("expected null level, got %v", v)
+		}
+		return Floor{FloorID: "attic", Name: "Loft"}, nil
+	})
+	defer server.Close()
+
+	client := createTestClient(server)
+
+	floor, err := client.UpdateFloor("attic", Floor{Name: "Loft"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if floor.Name != "Loft" {
+		t.Errorf("expected name 'Loft', got %s", floor.Name)
+	}
+}
+
+func TestClient_GetLabel(t *testing.T) {
+	server := newFakeWSServer(t, func(msg map[string]interface{}) (interface{}, *wsErrorBody) {
+		if msg["type"] != "config/label_registry/list" {
+			t.Errorf("expected type 'config/label_registry/list', got %v", msg["type"])
+		}
+		return []map[string]interface{}{
+			{"label_id": "critical", "name": "Critical", "color": "red", "icon": "mdi:alert", "description": nil},
+		}, nil
+	})
+	defer server.Close()
+
+	client := createTestClient(server)
+
+	label, err := client.GetLabel("critical")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if label.Name != "Critical" || label.Color != "red" {
+		t.Errorf("unexpected label: %+v", label)
+	}
+
+	_, err = client.GetLabel("missing")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestClient_CreateLabel(t *testing.T) {
+	server := newFakeWSServer(t, func(msg map[string]interface{}) (interface{}, *wsErrorBody) {
+		if msg["type"] != "config/label_registry/create" {
+			t.Errorf("expected type 'config/label_registry/create', got %v", msg["type"])
+		}
+		if msg["name"] != "Guest mode" || msg["color"] != "indigo" {
+			t.Errorf("unexpected payload: %v", msg)
+		}
+		for _, key := range []string{"icon", "description"} {
+			if v, ok := msg[key]; ok {
+				t.Errorf("expected no %s, got %v", key, v)
+			}
+		}
+		return Label{LabelID: "guest_mode", Name: "Guest mode", Color: "indigo"}, nil
+	})
+	defer server.Close()
+
+	client := createTestClient(server)
+
+	label, err := client.CreateLabel(Label{Name: "Guest mode", Color: "indigo"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if label.LabelID != "guest_mode" {
+		t.Errorf("expected label_id 'guest_mode', got %s", label.LabelID)
+	}
+}
+
+func TestClient_FindEntityID(t *testing.T) {
+	server := newFakeWSServer(t, func(msg map[string]interface{}) (interface{}, *wsErrorBody) {
+		if msg["type"] != "config/entity_registry/list" {
+			t.Errorf("expected type 'config/entity_registry/list', got %v", msg["type"])
+		}
+		return []EntityRegistryEntry{
+			{EntityID: "light.desk", UniqueID: "office", Platform: "hue"},
+			{EntityID: "zone.office", UniqueID: "office", Platform: "zone"},
+		}, nil
+	})
+	defer server.Close()
+
+	client := createTestClient(server)
+
+	entityID, err := client.FindEntityID("zone", "office")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if entityID != "zone.office" {
+		t.Errorf("expected entity_id 'zone.office', got %s", entityID)
+	}
+
+	_, err = client.FindEntityID("zone", "missing")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestClient_GetEntityRegistryEntryByID(t *testing.T) {
+	server := newFakeWSServer(t, func(msg map[string]interface{}) (interface{}, *wsErrorBody) {
+		switch msg["type"] {
+		case "config/entity_registry/list":
+			return []EntityRegistryEntry{
+				{ID: "abc123", EntityID: "light.hallway"},
+			}, nil
+		case "config/entity_registry/get":
+			if msg["entity_id"] != "light.hallway" {
+				t.Errorf("expected entity_id 'light.hallway', got %v", msg["entity_id"])
+			}
+			return map[string]interface{}{
+				"id": "abc123", "entity_id": "light.hallway", "aliases": []string{"corridor"},
+				"hidden_by": nil, "options": map[string]interface{}{"light": map[string]interface{}{}},
+			}, nil
+		}
+		t.Errorf("unexpected command %v", msg["type"])
+		return nil, nil
+	})
+	defer server.Close()
+
+	client := createTestClient(server)
+
+	entry, err := client.GetEntityRegistryEntryByID("abc123")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(entry.Aliases) != 1 || entry.Aliases[0] != "corridor" {
+		t.Errorf("expected aliases from the full entry, got %v", entry.Aliases)
+	}
+
+	_, err = client.GetEntityRegistryEntryByID("missing")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestClient_UpdateEntityRegistryEntry(t *testing.T) {
+	server := newFakeWSServer(t, func(msg map[string]interface{}) (interface{}, *wsErrorBody) {
+		if msg["type"] != "config/entity_registry/update" {
+			t.Errorf("expected type 'config/entity_registry/update', got %v", msg["type"])
+		}
+		if msg["entity_id"] != "light.hallway" {
+			t.Errorf("expected entity_id 'light.hallway', got %v", msg["entity_id"])
+		}
+		if v, ok := msg["icon"]; !ok || v != nil {
+			t.Errorf("expected null icon, got %v", v)
+		}
+		return map[string]interface{}{
+			"entity_entry": map[string]interface{}{
+				"id": "abc123", "entity_id": "light.corridor", "name": "Corridor",
+			},
+		}, nil
+	})
+	defer server.Close()
+
+	client := createTestClient(server)
+
+	entry, err := client.UpdateEntityRegistryEntry("light.hallway", map[string]interface{}{
+		"name":          "Corridor",
+		"icon":          nil,
+		"new_entity_id": "light.corridor",
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if entry.EntityID != "light.corridor" || entry.Name != "Corridor" {
+		t.Errorf("unexpected entry: %+v", entry)
+	}
+}
+
+func TestClient_GetDeviceRegistryEntries(t *testing.T) {
+	server := newFakeWSServer(t, func(msg map[string]interface{}) (interface{}, *wsErrorBody) {
+		if msg["type"] != "config/device_registry/list" {
+			t.Errorf("expected type 'config/device_registry/list', got %v", msg["type"])
+		}
+		return []map[string]interface{}{
+			{"id": "dev1", "name": "Desk Lamp", "area_id": "office", "labels": []string{"lighting"}},
+			{"id": "dev2", "name": "Router", "area_id": nil},
+		}, nil
+	})
+	defer server.Close()
+
+	client := createTestClient(server)
+
+	devices, err := client.GetDeviceRegistryEntries()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(devices) != 2 {
+		t.Fatalf("expected 2 devices, got %d", len(devices))
+	}
+	if devices[0].AreaID != "office" || len(devices[0].Labels) != 1 {
+		t.Errorf("unexpected first device: %+v", devices[0])
+	}
+	if devices[1].AreaID != "" {
+		t.Errorf("expected null area_id to decode as empty, got %q", devices[1].AreaID)
+	}
+}
+
+func TestClient_UpdateDeviceRegistryEntry(t *testing.T) {
+	server := newFakeWSServer(t, func(msg map[string]interface{}) (interface{}, *wsErrorBody) {
+		if msg["type"] != "config/device_registry/update" {
+			t.Errorf("expected type 'config/device_registry/update', got %v", msg["type"])
+		}
+		if msg["device_id"] != "dev1" {
+			t.Errorf("expected device_id 'dev1', got %v", msg["device_id"])
+		}
+		if msg["name_by_user"] != "Desk Lamp" {
+			t.Errorf("expected name_by_user 'Desk Lamp', got %v", msg["name_by_user"])
+		}
+		return map[string]interface{}{
+			"id": "dev1", "name": "Hue bulb", "name_by_user": "Desk Lamp",
+			"identifiers": [][]string{{"hue", "00:17:88:01"}},
+			"connections": [][]string{{"mac", "00:17:88:01:02:03"}},
+		}, nil
+	})
+	defer server.Close()
+
+	client := createTestClient(server)
+
+	device, err := client.UpdateDeviceRegistryEntry("dev1", map[string]interface{}{"name_by_user": "Desk Lamp"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if device.NameByUser != "Desk Lamp" {
+		t.Errorf("expected name_by_user 'Desk Lamp', got %s", device.NameByUser)
+	}
+	if len(device.Identifiers) != 1 || device.Identifiers[0][0] != "hue" {
+		t.Errorf("unexpected identifiers: %v", device.Identifiers)
+	}
+	if len(device.Connections) != 1 || device.Connections[0][1] != "00:17:88:01:02:03" {
+		t.Errorf("unexpected connections: %v", device.Connections)
+	}
+}
+
+func TestClient_GetCollectionItem(t *testing.T) {
+	server := newFakeWSServer(t, func(msg map[string]interface{}) (interface{}, *wsErrorBody) {
+		if msg["type"] != "counter/list" {
+			t.Errorf("expected type 'counter/list', got %v", msg["type"])
+		}
+		return []map[string]interface{}{
+			{"id": "visitors", "name": "Visitors", "step": 1},
+		}, nil
+	})
+	defer server.Close()
+
+	client := createTestClient(server)
+
+	item, err := client.GetCollectionItem("counter", "visitors")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if item["name"] != "Visitors" {
+		t.Errorf("expected name 'Visitors', got %v", item["name"])
+	}
+
+	_, err = client.GetCollectionItem("counter", "missing")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestClient_WebSocketTLS(t *testing.T) {
+	server := httptest.NewTLSServer(fakeWSServer(func(msg map[string]interface{}) (interface{}, *wsErrorBody) {
+		return true, nil
+	}))
+	defer server.Close()
+
+	client, err := New(Options{
+		BaseURL: server.URL + "/api",
+		Token:   "test-token",
+		TLS:     &TLSOptions{CACertPEM: serverCertPEM(server)},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	ws, err := client.WebSocket()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	defer ws.Close()
+
+	if !strings.HasPrefix(ws.URL, "wss://") {
+		t.Errorf("expected wss URL, got %s", ws.URL)
+	}
+
+	var ok bool
+	if err := ws.Command("get_config", nil, &ok); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
@@ -0,0 +1,96 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// GetZones retrieves all zones in the zone storage collection.
+// Zones defined in YAML are not part of the collection and are not returned.
+func (c *Client) GetZones() ([]Zone, error) {
+	return c.GetZonesContext(context.Background())
+}
+
+// GetZonesContext is like GetZones but uses the provided context.
+func (c *Client) GetZonesContext(ctx context.Context) ([]Zone, error) {
+	var zones []Zone
+	if err := c.ListCollectionContext(ctx, "zone", &zones); err != nil {
+		return nil, err
+	}
+
+	return zones, nil
+}
+
+// GetZone retrieves a single zone by its collection id.
+// Returns ErrNotFound if no such zone exists.
+func (c *Client) GetZone(id string) (*Zone, error) {
+	return c.GetZoneContext(context.Background(), id)
+}
+
+// GetZoneContext is like GetZone but uses the provided context.
+func (c *Client) GetZoneContext(ctx context.Context, id string) (*Zone, error) {
+	zones, err := c.GetZonesContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range zones {
+		if zones[i].ID == id {
+			return &zones[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("zone %s: %w", id, ErrNotFound)
+}
+
+// CreateZone creates a new zone and returns it with its generated id.
+func (c *Client) CreateZone(zone Zone) (*Zone, error) {
+	return c.CreateZoneContext(context.Background(), zone)
+}
+
+// CreateZoneContext is like CreateZone but uses the provided context.
+func (c *Client) CreateZoneContext(ctx context.Context, zone Zone) (*Zone, error) {
+	payload, err := toPayload(zone)
+	if err != nil {
+		return nil, err
+	}
+	delete(payload, "id")
+
+	var created Zone
+	if err := c.CreateCollectionItemContext(ctx, "zone", payload, &created); err != nil {
+		return nil, err
+	}
+
+	return &created, nil
+}
+
+// UpdateZone replaces the configuration of an existing zone.
+func (c *Client) UpdateZone(id string, zone Zone) (*Zone, error) {
+	return c.UpdateZoneContext(context.Background(), id, zone)
+}
+
+// UpdateZoneContext is like UpdateZone but uses the provided context.
+func (c *Client) UpdateZoneContext(ctx context.Context, id string, zone Zone) (*Zone, error) {
+	payload, err := toPayload(zone)
+	if err != nil {
+		return nil, err
+	}
+	delete(payload, "id")
+
+	var updated Zone
+	if err := c.UpdateCollectionItemContext(ctx, "zone", id, payload, &updated); err != nil {
+		return nil, err
+	}
+
+	return &updated, nil
+}
+
+// DeleteZone deletes a zone from the zone storage collection.
+func (c *Client) DeleteZone(id string) error {
+	return c.DeleteZoneContext(context.Background(), id)
+}
+
+// DeleteZoneContext is like DeleteZone but uses the provided context.
+func (c *Client) DeleteZoneContext(ctx context.Context, id string) error {
+	return c.DeleteCollectionItemContext(ctx, "zone", id)
+}
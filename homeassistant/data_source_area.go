@@ -0,0 +1,109 @@
+package homeassistant
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/dawwestk/terraform-provider-homeassistant/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceArea() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAreaRead,
+
+		Schema: map[string]*schema.Schema{
+			"area_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"area_id", "name"},
+				Description:  "ID of the area to look up (e.g., living_room).",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"area_id", "name"},
+				Description:  "Name of the area to look up. Matched case-insensitively.",
+			},
+			"aliases": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "Alternative names of the area.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"icon": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "MDI icon for the area.",
+			},
+			"picture": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "URL of a picture of the area.",
+			},
+			"floor_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the floor the area is on.",
+			},
+			"labels": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "IDs of the labels assigned to the area.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAreaRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	var diags diag.Diagnostics
+
+	areas, err := c.GetAreasContext(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	area, err := findArea(areas, d.Get("area_id").(string), d.Get("name").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(area.AreaID)
+	d.Set("area_id", area.AreaID)
+	d.Set("name", area.Name)
+	d.Set("aliases", area.Aliases)
+	d.Set("icon", area.Icon)
+	d.Set("picture", area.Picture)
+	d.Set("floor_id", area.FloorID)
+	d.Set("labels", area.Labels)
+
+	return diags
+}
+
+// findArea returns the area with the given ID, or else the one with the given name.
+func findArea(areas []client.Area, areaID, name string) (*client.Area, error) {
+	for i := range areas {
+		if areaID != "" && areas[i].AreaID == areaID {
+			return &areas[i], nil
+		}
+		if areaID == "" && strings.EqualFold(areas[i].Name, name) {
+			return &areas[i], nil
+		}
+	}
+
+	if areaID != "" {
+		return nil, fmt.Errorf("no area with ID %q", areaID)
+	}
+	return nil, fmt.Errorf("no area named %q", name)
+}
@@ -0,0 +1,81 @@
+package homeassistant
+
+import (
+	"testing"
+
+	"github.com/dawwestk/terraform-provider-homeassistant/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestDataSourceArea_Schema(t *testing.T) {
+	s := dataSourceArea().Schema
+
+	// Test lookup fields
+	lookupFields := []string{"area_id", "name"}
+	for _, field := range lookupFields {
+		if !s[field].Optional || !s[field].Computed {
+			t.Errorf("expected %s to be optional and computed", field)
+		}
+		if len(s[field].ExactlyOneOf) != 2 {
+			t.Errorf("expected %s to be mutually exclusive with the other lookup field", field)
+		}
+	}
+
+	// Test computed fields
+	computedFields := []string{"aliases", "icon", "picture", "floor_id", "labels"}
+	for _, field := range computedFields {
+		if !s[field].Computed {
+			t.Errorf("expected %s to be computed", field)
+		}
+	}
+}
+
+func TestFindArea(t *testing.T) {
+	areas := []client.Area{
+		{AreaID: "kitchen", Name: "Kitchen"},
+		{AreaID: "living_room", Name: "Living Room"},
+	}
+
+	area, err := findArea(areas, "living_room", "")
+	if err != nil || area.Name != "Living Room" {
+		t.Errorf("expected Living Room by ID, got %v, %v", area, err)
+	}
+
+	area, err = findArea(areas, "", "kitchen")
+	if err != nil || area.AreaID != "kitchen" {
+		t.Errorf("expected kitchen by case-insensitive name, got %v, %v", area, err)
+	}
+
+	if _, err := findArea(areas, "garage", ""); err == nil {
+		t.Error("expected error for unknown area ID")
+	}
+	if _, err := findArea(areas, "", "Garage"); err == nil {
+		t.Error("expected error for unknown area name")
+	}
+}
+
+// Acceptance tests - require a real Home Assistant instance
+
+func TestAccDataSourceArea_byName(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAreaConfig_byName(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.homeassistant_area.test", "area_id", "homeassistant_area.test", "area_id"),
+					resource.TestCheckResourceAttr("data.homeassistant_area.test", "icon", "mdi:flask"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAreaConfig_byName() string {
+	return testAccResourceAreaConfig_basic("mdi:flask") + `
+data "homeassistant_area" "test" {
+  name = homeassistant_area.test.name
+}
+`
+}
@@ -0,0 +1,341 @@
+package homeassistant
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dawwestk/terraform-provider-homeassistant/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceDevices() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDevicesRead,
+
+		Schema: map[string]*schema.Schema{
+			"manufacturer": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Only include devices of this manufacturer. Case-insensitive.",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"model": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Only include devices of this model. Case-insensitive.",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"integration": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Only include devices identified by this integration (e.g., hue).",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"mac_address": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Only include devices with a connection of this address, such as a network or Bluetooth MAC address. Case-insensitive.",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"area_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Only include devices in this area.",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"device_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "IDs of the matching devices, in the same order as devices.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"devices": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The matching devices, sorted by name.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"device_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the device.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the device provided by its integration.",
+						},
+						"name_by_user": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the device given by the user.",
+						},
+						"manufacturer": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Manufacturer of the device.",
+						},
+						"model": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Model of the device.",
+						},
+						"model_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Model identifier of the device.",
+						},
+						"sw_version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Firmware version of the device.",
+						},
+						"hw_version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Hardware version of the device.",
+						},
+						"serial_number": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Serial number of the device.",
+						},
+						"area_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the area of the device.",
+						},
+						"labels": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "IDs of the labels assigned to the device.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"disabled": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the device is disabled.",
+						},
+						"via_device_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the device through which this device is connected, such as a hub.",
+						},
+						"integrations": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Integrations identifying the device.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"identifiers": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Identifiers of the device within its integrations.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"integration": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"connections": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Connections of the device, such as its MAC address.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"value": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"entity_ids": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Sorted IDs of the entities of the device.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// deviceFilter holds the criteria of a homeassistant_devices data source.
+// Empty fields match every device.
+type deviceFilter struct {
+	Manufacturer string
+	Model        string
+	Integration  string
+	MACAddress   string
+	AreaID       string
+}
+
+func (f deviceFilter) matches(device client.DeviceRegistryEntry) bool {
+	if f.Manufacturer != "" && !strings.EqualFold(device.Manufacturer, f.Manufacturer) {
+		return false
+	}
+	if f.Model != "" && !strings.EqualFold(device.Model, f.Model) {
+		return false
+	}
+	if f.Integration != "" && !slices.Contains(deviceIntegrations(device), f.Integration) {
+		return false
+	}
+	if f.AreaID != "" && device.AreaID != f.AreaID {
+		return false
+	}
+	if f.MACAddress != "" {
+		found := false
+		for _, conn := range device.Connections {
+			if len(conn) == 2 && strings.EqualFold(conn[1], f.MACAddress) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// deviceIntegrations returns the integration domains of the device
+// identifiers, in order of first appearance.
+func deviceIntegrations(device client.DeviceRegistryEntry) []string {
+	integrations := make([]string, 0, len(device.Identifiers))
+	for _, identifier := range device.Identifiers {
+		if len(identifier) > 0 && !slices.Contains(integrations, identifier[0]) {
+			integrations = append(integrations, identifier[0])
+		}
+	}
+	return integrations
+}
+
+// devicePairs flattens identifier or connection pairs into blocks with the given keys.
+func devicePairs(pairs [][]string, firstKey, secondKey string) []interface{} {
+	blocks := make([]interface{}, 0, len(pairs))
+	for _, pair := range pairs {
+		if len(pair) != 2 {
+			continue
+		}
+		blocks = append(blocks, map[string]interface{}{
+			firstKey:  pair[0],
+			secondKey: pair[1],
+		})
+	}
+	return blocks
+}
+
+// deviceDisplayName returns the name shown for a device in Home Assistant.
+func deviceDisplayName(device client.DeviceRegistryEntry) string {
+	if device.NameByUser != "" {
+		return device.NameByUser
+	}
+	return device.Name
+}
+
+func dataSourceDevicesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	var diags diag.Diagnostics
+
+	filter := deviceFilter{
+		Manufacturer: d.Get("manufacturer").(string),
+		Model:        d.Get("model").(string),
+		Integration:  d.Get("integration").(string),
+		MACAddress:   d.Get("mac_address").(string),
+		AreaID:       d.Get("area_id").(string),
+	}
+
+	devices, err := c.GetDeviceRegistryEntriesContext(ctx)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to list devices: %w", err))
+	}
+
+	entities, err := c.GetEntityRegistryEntriesContext(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	entityIDs := make(map[string][]string)
+	for _, entity := range entities {
+		if entity.DeviceID != "" {
+			entityIDs[entity.DeviceID] = append(entityIDs[entity.DeviceID], entity.EntityID)
+		}
+	}
+
+	sort.Slice(devices, func(i, j int) bool {
+		a, b := deviceDisplayName(devices[i]), deviceDisplayName(devices[j])
+		if a != b {
+			return a < b
+		}
+		return devices[i].ID < devices[j].ID
+	})
+
+	deviceIDs := make([]string, 0)
+	result := make([]interface{}, 0)
+
+	for _, device := range devices {
+		if !filter.matches(device) {
+			continue
+		}
+
+		ids := entityIDs[device.ID]
+		sort.Strings(ids)
+
+		deviceIDs = append(deviceIDs, device.ID)
+		result = append(result, map[string]interface{}{
+			"device_id":     device.ID,
+			"name":          device.Name,
+			"name_by_user":  device.NameByUser,
+			"manufacturer":  device.Manufacturer,
+			"model":         device.Model,
+			"model_id":      device.ModelID,
+			"sw_version":    device.SWVersion,
+			"hw_version":    device.HWVersion,
+			"serial_number": device.SerialNumber,
+			"area_id":       device.AreaID,
+			"labels":        device.Labels,
+			"disabled":      device.DisabledBy != "",
+			"via_device_id": device.ViaDeviceID,
+			"integrations":  deviceIntegrations(device),
+			"identifiers":   devicePairs(device.Identifiers, "integration", "id"),
+			"connections":   devicePairs(device.Connections, "type", "value"),
+			"entity_ids":    ids,
+		})
+	}
+
+	d.SetId(strconv.Itoa(schema.HashString(strings.Join(deviceIDs, ","))))
+	d.Set("device_ids", deviceIDs)
+	d.Set("devices", result)
+
+	return diags
+}
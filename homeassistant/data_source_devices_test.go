@@ -0,0 +1,113 @@
+package homeassistant
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/dawwestk/terraform-provider-homeassistant/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestDataSourceDevices_Schema(t *testing.T) {
+	s := dataSourceDevices().Schema
+
+	// Test optional filters
+	optionalFields := []string{"manufacturer", "model", "integration", "mac_address", "area_id"}
+	for _, field := range optionalFields {
+		if !s[field].Optional {
+			t.Errorf("expected %s to be optional", field)
+		}
+	}
+
+	// Test computed fields
+	computedFields := []string{"device_ids", "devices"}
+	for _, field := range computedFields {
+		if !s[field].Computed {
+			t.Errorf("expected %s to be computed", field)
+		}
+	}
+}
+
+func TestDeviceFilter_Matches(t *testing.T) {
+	bulb := client.DeviceRegistryEntry{
+		ID:           "dev1",
+		Manufacturer: "Signify Netherlands B.V.",
+		Model:        "Hue color lamp",
+		AreaID:       "office",
+		Identifiers:  [][]string{{"hue", "00:17:88:01:02:03:04:05-0b"}},
+		Connections:  [][]string{{"zigbee", "00:17:88:01:02:03:04:05"}, {"mac", "00:17:88:aa:bb:cc"}},
+	}
+
+	tests := []struct {
+		name     string
+		filter   deviceFilter
+		expected bool
+	}{
+		{"empty filter", deviceFilter{}, true},
+		{"manufacturer", deviceFilter{Manufacturer: "signify netherlands b.v."}, true},
+		{"manufacturer mismatch", deviceFilter{Manufacturer: "IKEA"}, false},
+		{"model", deviceFilter{Model: "Hue color lamp"}, true},
+		{"model mismatch", deviceFilter{Model: "Hue white lamp"}, false},
+		{"integration", deviceFilter{Integration: "hue"}, true},
+		{"integration mismatch", deviceFilter{Integration: "zha"}, false},
+		{"mac address", deviceFilter{MACAddress: "00:17:88:AA:BB:CC"}, true},
+		{"mac address mismatch", deviceFilter{MACAddress: "00:17:88:aa:bb:cd"}, false},
+		{"area", deviceFilter{AreaID: "office"}, true},
+		{"area mismatch", deviceFilter{AreaID: "kitchen"}, false},
+		{"combined", deviceFilter{Integration: "hue", AreaID: "office"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.matches(bulb); got != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestDeviceIntegrations(t *testing.T) {
+	device := client.DeviceRegistryEntry{
+		Identifiers: [][]string{{"hue", "a"}, {"matter", "b"}, {"hue", "c"}},
+	}
+
+	expected := []string{"hue", "matter"}
+	if got := deviceIntegrations(device); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestDevicePairs(t *testing.T) {
+	pairs := devicePairs([][]string{{"mac", "00:17:88:aa:bb:cc"}, {"malformed"}}, "type", "value")
+
+	expected := []interface{}{
+		map[string]interface{}{"type": "mac", "value": "00:17:88:aa:bb:cc"},
+	}
+	if !reflect.DeepEqual(pairs, expected) {
+		t.Errorf("expected %v, got %v", expected, pairs)
+	}
+}
+
+// Acceptance tests - require a real Home Assistant instance
+
+func TestAccDataSourceDevices_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceDevicesConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.homeassistant_devices.all", "id"),
+					resource.TestCheckResourceAttrSet("data.homeassistant_devices.all", "devices.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceDevicesConfig_basic() string {
+	return `
+data "homeassistant_devices" "all" {}
+`
+}
@@ -0,0 +1,375 @@
+package homeassistant
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dawwestk/terraform-provider-homeassistant/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceEntities() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceEntitiesRead,
+
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Only include entities of this domain (e.g., light).",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"entity_id_glob": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Only include entities whose ID matches this glob pattern (e.g., light.kitchen_*).",
+				ValidateFunc: validateGlob,
+			},
+			"entity_id_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Only include entities whose ID matches this regular expression.",
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only include entities currently in this state (e.g., on).",
+			},
+			"attribute": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Only include entities whose attributes match. All blocks must match.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "Name of the attribute.",
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Expected value, compared as a string. If unset, the attribute only has to be present.",
+						},
+					},
+				},
+			},
+			"area_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Only include entities in this area, either directly or through their device.",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"device_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Only include entities belonging to this device.",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"label": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Only include entities with this label ID.",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"entity_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Sorted IDs of the matching entities.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"states": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Current state of each matching entity, keyed by entity ID. Suitable for for_each.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"entities": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The matching entities, sorted by entity ID.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"entity_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the entity.",
+						},
+						"domain": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Domain of the entity.",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Current state of the entity.",
+						},
+						"friendly_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Friendly name of the entity.",
+						},
+						"attributes": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Description: "Entity attributes as strings. Lists and objects are JSON encoded.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"attributes_json": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "All entity attributes as a JSON object.",
+						},
+						"last_changed": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Timestamp of the last state change.",
+						},
+						"last_updated": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Timestamp of the last update.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// entityFilter holds the criteria of a homeassistant_entities data source.
+// Empty fields match every entity.
+type entityFilter struct {
+	Domain     string
+	Glob       string
+	Regex      *regexp.Regexp
+	State      string
+	Attributes []attributeFilter
+	AreaID     string
+	DeviceID   string
+	Label      string
+}
+
+// attributeFilter matches an attribute by its flattened string value,
+// or by presence alone when Value is empty.
+type attributeFilter struct {
+	Name  string
+	Value string
+}
+
+// entityPlacement is where an entity sits in the registries.
+type entityPlacement struct {
+	AreaID   string
+	DeviceID string
+	Labels   []string
+}
+
+// needsRegistry reports whether the filter requires registry lookups.
+func (f entityFilter) needsRegistry() bool {
+	return f.AreaID != "" || f.DeviceID != "" || f.Label != ""
+}
+
+// matches reports whether the entity satisfies every criterion of the filter.
+// placement may be nil when the entity is not in the entity registry.
+func (f entityFilter) matches(state client.State, placement *entityPlacement) bool {
+	domain, _, _ := strings.Cut(state.EntityID, ".")
+	if f.Domain != "" && domain != f.Domain {
+		return false
+	}
+	if f.Glob != "" {
+		if ok, _ := path.Match(f.Glob, state.EntityID); !ok {
+			return false
+		}
+	}
+	if f.Regex != nil && !f.Regex.MatchString(state.EntityID) {
+		return false
+	}
+	if f.State != "" && state.State != f.State {
+		return false
+	}
+
+	if len(f.Attributes) > 0 {
+		attributes := flattenAttributes(state.Attributes)
+		for _, attr := range f.Attributes {
+			value, ok := attributes[attr.Name]
+			if !ok || (attr.Value != "" && value != attr.Value) {
+				return false
+			}
+		}
+	}
+
+	if f.needsRegistry() {
+		if placement == nil {
+			return false
+		}
+		if f.AreaID != "" && placement.AreaID != f.AreaID {
+			return false
+		}
+		if f.DeviceID != "" && placement.DeviceID != f.DeviceID {
+			return false
+		}
+		if f.Label != "" && !slices.Contains(placement.Labels, f.Label) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// entityPlacements indexes the entity registry by entity ID. Entities without
+// an area of their own inherit the area of their device.
+func entityPlacements(entities []client.EntityRegistryEntry, devices []client.DeviceRegistryEntry) map[string]*entityPlacement {
+	deviceAreas := make(map[string]string, len(devices))
+	for _, device := range devices {
+		deviceAreas[device.ID] = device.AreaID
+	}
+
+	placements := make(map[string]*entityPlacement, len(entities))
+	for _, entry := range entities {
+		areaID := entry.AreaID
+		if areaID == "" && entry.DeviceID != "" {
+			areaID = deviceAreas[entry.DeviceID]
+		}
+		placements[entry.EntityID] = &entityPlacement{
+			AreaID:   areaID,
+			DeviceID: entry.DeviceID,
+			Labels:   entry.Labels,
+		}
+	}
+
+	return placements
+}
+
+func dataSourceEntitiesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	var diags diag.Diagnostics
+
+	filter := entityFilterFromResourceData(d)
+
+	states, err := c.GetStatesContext(ctx)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to list entities: %w", err))
+	}
+
+	var placements map[string]*entityPlacement
+	if filter.needsRegistry() {
+		entities, err := c.GetEntityRegistryEntriesContext(ctx)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		var devices []client.DeviceRegistryEntry
+		if filter.AreaID != "" {
+			devices, err = c.GetDeviceRegistryEntriesContext(ctx)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		placements = entityPlacements(entities, devices)
+	}
+
+	sort.Slice(states, func(i, j int) bool {
+		return states[i].EntityID < states[j].EntityID
+	})
+
+	entityIDs := make([]string, 0)
+	stateMap := make(map[string]string)
+	entities := make([]interface{}, 0)
+
+	for _, state := range states {
+		if !filter.matches(state, placements[state.EntityID]) {
+			continue
+		}
+
+		attributesJSON, err := json.Marshal(state.Attributes)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed to encode attributes of %s: %w", state.EntityID, err))
+		}
+		if state.Attributes == nil {
+			attributesJSON = []byte("{}")
+		}
+
+		domain, _, _ := strings.Cut(state.EntityID, ".")
+		friendlyName, _ := state.Attributes["friendly_name"].(string)
+
+		entityIDs = append(entityIDs, state.EntityID)
+		stateMap[state.EntityID] = state.State
+		entities = append(entities, map[string]interface{}{
+			"entity_id":       state.EntityID,
+			"domain":          domain,
+			"state":           state.State,
+			"friendly_name":   friendlyName,
+			"attributes":      flattenAttributes(state.Attributes),
+			"attributes_json": string(attributesJSON),
+			"last_changed":    state.LastChanged,
+			"last_updated":    state.LastUpdated,
+		})
+	}
+
+	d.SetId(strconv.Itoa(schema.HashString(strings.Join(entityIDs, ","))))
+	d.Set("entity_ids", entityIDs)
+	d.Set("states", stateMap)
+	d.Set("entities", entities)
+
+	return diags
+}
+
+func entityFilterFromResourceData(d *schema.ResourceData) entityFilter {
+	filter := entityFilter{
+		Domain:   d.Get("domain").(string),
+		Glob:     d.Get("entity_id_glob").(string),
+		State:    d.Get("state").(string),
+		AreaID:   d.Get("area_id").(string),
+		DeviceID: d.Get("device_id").(string),
+		Label:    d.Get("label").(string),
+	}
+
+	// The pattern has already been checked by the schema validation
+	if pattern := d.Get("entity_id_regex").(string); pattern != "" {
+		filter.Regex = regexp.MustCompile(pattern)
+	}
+
+	for _, raw := range d.Get("attribute").([]interface{}) {
+		attr := raw.(map[string]interface{})
+		filter.Attributes = append(filter.Attributes, attributeFilter{
+			Name:  attr["name"].(string),
+			Value: attr["value"].(string),
+		})
+	}
+
+	return filter
+}
+
+// validateGlob checks that a value is a well-formed glob pattern.
+func validateGlob(v interface{}, k string) ([]string, []error) {
+	pattern, ok := v.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected %s to be a string", k)}
+	}
+
+	if _, err := path.Match(pattern, ""); err != nil {
+		return nil, []error{fmt.Errorf("%s is not a valid glob pattern: %w", k, err)}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,191 @@
+package homeassistant
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/dawwestk/terraform-provider-homeassistant/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDataSourceEntities_Schema(t *testing.T) {
+	s := dataSourceEntities().Schema
+
+	// Test optional filters
+	optionalFields := []string{
+		"domain", "entity_id_glob", "entity_id_regex", "state",
+		"attribute", "area_id", "device_id", "label",
+	}
+	for _, field := range optionalFields {
+		if !s[field].Optional {
+			t.Errorf("expected %s to be optional", field)
+		}
+	}
+
+	// Test computed fields
+	computedFields := []string{"entity_ids", "states", "entities"}
+	for _, field := range computedFields {
+		if !s[field].Computed {
+			t.Errorf("expected %s to be computed", field)
+		}
+	}
+}
+
+func TestDataSourceEntities_GlobValidation(t *testing.T) {
+	s := dataSourceEntities().Schema
+
+	if _, errs := s["entity_id_glob"].ValidateFunc("light.kitchen_*", "entity_id_glob"); len(errs) > 0 {
+		t.Errorf("expected valid glob, got %v", errs)
+	}
+	if _, errs := s["entity_id_glob"].ValidateFunc("light.[kitchen", "entity_id_glob"); len(errs) == 0 {
+		t.Error("expected error for malformed glob")
+	}
+}
+
+func TestEntityFilter_Matches(t *testing.T) {
+	lamp := client.State{
+		EntityID: "light.kitchen_lamp",
+		State:    "on",
+		Attributes: map[string]interface{}{
+			"brightness":            float64(255),
+			"supported_color_modes": []interface{}{"brightness"},
+		},
+	}
+	placement := &entityPlacement{AreaID: "kitchen", DeviceID: "dev1", Labels: []string{"evening"}}
+
+	tests := []struct {
+		name      string
+		filter    entityFilter
+		placement *entityPlacement
+		expected  bool
+	}{
+		{"empty filter", entityFilter{}, nil, true},
+		{"domain", entityFilter{Domain: "light"}, nil, true},
+		{"other domain", entityFilter{Domain: "switch"}, nil, false},
+		{"glob", entityFilter{Glob: "light.kitchen_*"}, nil, true},
+		{"glob mismatch", entityFilter{Glob: "light.hall_*"}, nil, false},
+		{"regex", entityFilter{Regex: regexp.MustCompile(`_lamp$`)}, nil, true},
+		{"regex mismatch", entityFilter{Regex: regexp.MustCompile(`^switch\.`)}, nil, false},
+		{"state", entityFilter{State: "on"}, nil, true},
+		{"state mismatch", entityFilter{State: "off"}, nil, false},
+		{"attribute value", entityFilter{Attributes: []attributeFilter{{Name: "brightness", Value: "255"}}}, nil, true},
+		{"attribute list value", entityFilter{Attributes: []attributeFilter{{Name: "supported_color_modes", Value: `["brightness"]`}}}, nil, true},
+		{"attribute presence", entityFilter{Attributes: []attributeFilter{{Name: "brightness"}}}, nil, true},
+		{"attribute missing", entityFilter{Attributes: []attributeFilter{{Name: "color_temp"}}}, nil, false},
+		{"attribute mismatch", entityFilter{Attributes: []attributeFilter{{Name: "brightness", Value: "128"}}}, nil, false},
+		{"area", entityFilter{AreaID: "kitchen"}, placement, true},
+		{"area mismatch", entityFilter{AreaID: "hallway"}, placement, false},
+		{"area without registry entry", entityFilter{AreaID: "kitchen"}, nil, false},
+		{"device", entityFilter{DeviceID: "dev1"}, placement, true},
+		{"label", entityFilter{Label: "evening"}, placement, true},
+		{"label mismatch", entityFilter{Label: "morning"}, placement, false},
+		{"combined", entityFilter{Domain: "light", State: "on", AreaID: "kitchen"}, placement, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.matches(lamp, tt.placement); got != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestEntityPlacements_InheritsDeviceArea(t *testing.T) {
+	placements := entityPlacements(
+		[]client.EntityRegistryEntry{
+			{EntityID: "light.kitchen_lamp", DeviceID: "dev1"},
+			{EntityID: "sensor.kitchen_temperature", DeviceID: "dev1", AreaID: "pantry"},
+			{EntityID: "sun.sun"},
+		},
+		[]client.DeviceRegistryEntry{
+			{ID: "dev1", AreaID: "kitchen"},
+		},
+	)
+
+	if placements["light.kitchen_lamp"].AreaID != "kitchen" {
+		t.Errorf("expected light to inherit area 'kitchen', got %q", placements["light.kitchen_lamp"].AreaID)
+	}
+	if placements["sensor.kitchen_temperature"].AreaID != "pantry" {
+		t.Errorf("expected sensor area 'pantry', got %q", placements["sensor.kitchen_temperature"].AreaID)
+	}
+	if placements["sun.sun"].AreaID != "" {
+		t.Errorf("expected no area for sun.sun, got %q", placements["sun.sun"].AreaID)
+	}
+}
+
+func TestDataSourceEntitiesRead(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]client.State{
+			{EntityID: "switch.fan", State: "on"},
+			{EntityID: "light.kitchen", State: "on", Attributes: map[string]interface{}{"friendly_name": "Kitchen"}},
+			{EntityID: "light.hallway", State: "off"},
+			{EntityID: "light.bedroom", State: "on"},
+		})
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, dataSourceEntities().Schema, map[string]interface{}{
+		"domain": "light",
+		"state":  "on",
+	})
+
+	diags := dataSourceEntitiesRead(context.Background(), d, testClient(server))
+	if diags.HasError() {
+		t.Fatalf("expected no error, got %v", diags)
+	}
+
+	entityIDs := d.Get("entity_ids").([]interface{})
+	if len(entityIDs) != 2 || entityIDs[0] != "light.bedroom" || entityIDs[1] != "light.kitchen" {
+		t.Errorf("expected [light.bedroom light.kitchen], got %v", entityIDs)
+	}
+	states := d.Get("states").(map[string]interface{})
+	if len(states) != 2 || states["light.kitchen"] != "on" {
+		t.Errorf("expected states to contain light.kitchen, got %v", states)
+	}
+	if d.Get("entities.1.friendly_name") != "Kitchen" {
+		t.Errorf("expected friendly_name 'Kitchen', got %v", d.Get("entities.1.friendly_name"))
+	}
+	if d.Get("entities.1.domain") != "light" {
+		t.Errorf("expected domain 'light', got %v", d.Get("entities.1.domain"))
+	}
+}
+
+// Acceptance tests - require a real Home Assistant instance
+
+func TestAccDataSourceEntities_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceEntitiesConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.homeassistant_entities.sun", "entity_ids.#", "1"),
+					resource.TestCheckResourceAttr("data.homeassistant_entities.sun", "entity_ids.0", "sun.sun"),
+					resource.TestCheckResourceAttr("data.homeassistant_entities.sun", "entities.0.domain", "sun"),
+					resource.TestCheckResourceAttrSet("data.homeassistant_entities.sun", "states.sun.sun"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceEntitiesConfig_basic() string {
+	return `
+data "homeassistant_entities" "sun" {
+  domain         = "sun"
+  entity_id_glob = "sun.*"
+
+  attribute {
+    name = "next_rising"
+  }
+}
+`
+}
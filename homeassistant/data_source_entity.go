@@ -0,0 +1,163 @@
+package homeassistant
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/dawwestk/terraform-provider-homeassistant/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceEntity() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceEntityRead,
+
+		Schema: map[string]*schema.Schema{
+			"entity_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The entity ID to read (e.g., sensor.outdoor_temperature).",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Current state of the entity.",
+			},
+			"friendly_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Friendly name of the entity.",
+			},
+			"attributes": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Entity attributes as strings. Lists and objects are JSON encoded.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"attributes_json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "All entity attributes as a JSON object, for use with jsondecode().",
+			},
+			"last_changed": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Timestamp of the last state change.",
+			},
+			"last_updated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Timestamp of the last update.",
+			},
+			"context": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Context of the last state change.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the context.",
+						},
+						"parent_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the parent context, if any.",
+						},
+						"user_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the user that caused the change, if any.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceEntityRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	var diags diag.Diagnostics
+
+	entityID := d.Get("entity_id").(string)
+
+	state, err := c.GetStateContext(ctx, entityID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read entity state: %w", err))
+	}
+
+	attributesJSON, err := json.Marshal(state.Attributes)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to encode attributes of %s: %w", entityID, err))
+	}
+	if state.Attributes == nil {
+		attributesJSON = []byte("{}")
+	}
+
+	d.SetId(entityID)
+	d.Set("state", state.State)
+	d.Set("attributes", flattenAttributes(state.Attributes))
+	d.Set("attributes_json", string(attributesJSON))
+	d.Set("last_changed", state.LastChanged)
+	d.Set("last_updated", state.LastUpdated)
+	d.Set("context", flattenContext(state.Context))
+
+	if friendlyName, ok := state.Attributes["friendly_name"]; ok {
+		if fn, ok := friendlyName.(string); ok {
+			d.Set("friendly_name", fn)
+		}
+	}
+
+	return diags
+}
+
+// flattenAttributes converts entity attributes to a map of strings.
+// Strings are kept as is, numbers and booleans are formatted, null becomes
+// an empty string, and lists and objects are JSON encoded.
+func flattenAttributes(attributes map[string]interface{}) map[string]string {
+	flat := make(map[string]string, len(attributes))
+
+	for name, value := range attributes {
+		switch v := value.(type) {
+		case nil:
+			flat[name] = ""
+		case string:
+			flat[name] = v
+		case bool:
+			flat[name] = strconv.FormatBool(v)
+		case float64:
+			flat[name] = strconv.FormatFloat(v, 'f', -1, 64)
+		default:
+			encoded, err := json.Marshal(v)
+			if err != nil {
+				encoded = []byte(fmt.Sprint(v))
+			}
+			flat[name] = string(encoded)
+		}
+	}
+
+	return flat
+}
+
+// flattenContext converts a state change context to its schema representation.
+func flattenContext(stateContext *client.Context) []interface{} {
+	if stateContext == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"id":        stateContext.ID,
+			"parent_id": stateContext.ParentID,
+			"user_id":   stateContext.UserID,
+		},
+	}
+}
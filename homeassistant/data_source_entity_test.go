@@ -0,0 +1,140 @@
+package homeassistant
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dawwestk/terraform-provider-homeassistant/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDataSourceEntity_Schema(t *testing.T) {
+	s := dataSourceEntity().Schema
+
+	// Test required field
+	if !s["entity_id"].Required {
+		t.Error("expected entity_id to be required")
+	}
+
+	// Test computed fields
+	computedFields := []string{
+		"state", "friendly_name", "attributes", "attributes_json",
+		"last_changed", "last_updated", "context",
+	}
+	for _, field := range computedFields {
+		if !s[field].Computed {
+			t.Errorf("expected %s to be computed", field)
+		}
+	}
+}
+
+func TestFlattenAttributes(t *testing.T) {
+	flat := flattenAttributes(map[string]interface{}{
+		"friendly_name":    "Thermostat",
+		"temperature":      float64(21.5),
+		"min_temp":         float64(7),
+		"hvac_modes":       []interface{}{"off", "heat"},
+		"preset":           nil,
+		"is_on":            true,
+		"target_temp_step": map[string]interface{}{"value": float64(0.5)},
+	})
+
+	expected := map[string]string{
+		"friendly_name":    "Thermostat",
+		"temperature":      "21.5",
+		"min_temp":         "7",
+		"hvac_modes":       `["off","heat"]`,
+		"preset":           "",
+		"is_on":            "true",
+		"target_temp_step": `{"value":0.5}`,
+	}
+
+	for name, want := range expected {
+		if flat[name] != want {
+			t.Errorf("expected %s to be %q, got %q", name, want, flat[name])
+		}
+	}
+}
+
+func TestDataSourceEntityRead(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/states/climate.hallway" {
+			t.Errorf("expected path '/states/climate.hallway', got %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(client.State{
+			EntityID:    "climate.hallway",
+			State:       "heat",
+			LastChanged: "2024-01-01T00:00:00+00:00",
+			Attributes: map[string]interface{}{
+				"friendly_name": "Hallway",
+				"temperature":   float64(20),
+			},
+			Context: &client.Context{ID: "01HXYZ", UserID: "abc"},
+		})
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, dataSourceEntity().Schema, map[string]interface{}{
+		"entity_id": "climate.hallway",
+	})
+
+	diags := dataSourceEntityRead(context.Background(), d, testClient(server))
+	if diags.HasError() {
+		t.Fatalf("expected no error, got %v", diags)
+	}
+
+	if d.Get("state") != "heat" {
+		t.Errorf("expected state 'heat', got %v", d.Get("state"))
+	}
+	if d.Get("friendly_name") != "Hallway" {
+		t.Errorf("expected friendly_name 'Hallway', got %v", d.Get("friendly_name"))
+	}
+	if d.Get("attributes.temperature") != "20" {
+		t.Errorf("expected attributes.temperature '20', got %v", d.Get("attributes.temperature"))
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal([]byte(d.Get("attributes_json").(string)), &decoded); err != nil {
+		t.Fatalf("expected attributes_json to be valid JSON, got %v", err)
+	}
+	if decoded["temperature"] != float64(20) {
+		t.Errorf("expected temperature 20 in attributes_json, got %v", decoded["temperature"])
+	}
+
+	if d.Get("context.0.id") != "01HXYZ" {
+		t.Errorf("expected context id '01HXYZ', got %v", d.Get("context.0.id"))
+	}
+}
+
+// Acceptance tests - require a real Home Assistant instance
+
+func TestAccDataSourceEntity_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceEntityConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.homeassistant_entity.sun", "entity_id", "sun.sun"),
+					resource.TestCheckResourceAttrSet("data.homeassistant_entity.sun", "state"),
+					resource.TestCheckResourceAttrSet("data.homeassistant_entity.sun", "attributes_json"),
+					resource.TestCheckResourceAttrSet("data.homeassistant_entity.sun", "attributes.friendly_name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceEntityConfig_basic() string {
+	return `
+data "homeassistant_entity" "sun" {
+  entity_id = "sun.sun"
+}
+`
+}
@@ -0,0 +1,138 @@
+package homeassistant
+
+import (
+	"context"
+	"sort"
+
+	"github.com/dawwestk/terraform-provider-homeassistant/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFloors() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceFloorsRead,
+
+		Schema: map[string]*schema.Schema{
+			"floors": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "All floors, ordered by level and then name. Floors without a level come last.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"floor_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the floor.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the floor.",
+						},
+						"level": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Level of the floor. Only meaningful if has_level is true.",
+						},
+						"has_level": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether a level is set for the floor.",
+						},
+						"icon": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "MDI icon for the floor.",
+						},
+						"aliases": {
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Description: "Alternative names of the floor.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"area_ids": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Sorted IDs of the areas on the floor.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceFloorsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	var diags diag.Diagnostics
+
+	floors, err := c.GetFloorsContext(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	areas, err := c.GetAreasContext(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("floors")
+	d.Set("floors", flattenFloors(floors, areas))
+
+	return diags
+}
+
+// flattenFloors converts floors to their schema representation, listing the
+// areas on each floor and ordering floors by level and then name.
+func flattenFloors(floors []client.Floor, areas []client.Area) []interface{} {
+	areaIDs := make(map[string][]string)
+	for _, area := range areas {
+		if area.FloorID != "" {
+			areaIDs[area.FloorID] = append(areaIDs[area.FloorID], area.AreaID)
+		}
+	}
+
+	sort.SliceStable(floors, func(i, j int) bool {
+		a, b := floors[i], floors[j]
+		if (a.Level == nil) != (b.Level == nil) {
+			return a.Level != nil
+		}
+		if a.Level != nil && *a.Level != *b.Level {
+			return *a.Level < *b.Level
+		}
+		return a.Name < b.Name
+	})
+
+	result := make([]interface{}, 0, len(floors))
+	for _, floor := range floors {
+		ids := areaIDs[floor.FloorID]
+		sort.Strings(ids)
+		if ids == nil {
+			ids = []string{}
+		}
+
+		level := 0
+		if floor.Level != nil {
+			level = *floor.Level
+		}
+
+		result = append(result, map[string]interface{}{
+			"floor_id":  floor.FloorID,
+			"name":      floor.Name,
+			"level":     level,
+			"has_level": floor.Level != nil,
+			"icon":      floor.Icon,
+			"aliases":   floor.Aliases,
+			"area_ids":  ids,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,99 @@
+package homeassistant
+
+import (
+	"testing"
+
+	"github.com/dawwestk/terraform-provider-homeassistant/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestDataSourceFloors_Schema(t *testing.T) {
+	s := dataSourceFloors().Schema
+
+	if !s["floors"].Computed {
+		t.Error("expected floors to be computed")
+	}
+}
+
+func TestFlattenFloors(t *testing.T) {
+	level := func(l int) *int { return &l }
+
+	floors := []client.Floor{
+		{FloorID: "attic", Name: "Attic"},
+		{FloorID: "first", Name: "First Floor", Level: level(1)},
+		{FloorID: "ground", Name: "Ground Floor", Level: level(0)},
+	}
+	areas := []client.Area{
+		{AreaID: "living_room", FloorID: "ground"},
+		{AreaID: "kitchen", FloorID: "ground"},
+		{AreaID: "bedroom", FloorID: "first"},
+		{AreaID: "garden"},
+	}
+
+	result := flattenFloors(floors, areas)
+	if len(result) != 3 {
+		t.Fatalf("expected 3 floors, got %d", len(result))
+	}
+
+	expectedOrder := []string{"ground", "first", "attic"}
+	for i, floorID := range expectedOrder {
+		if got := result[i].(map[string]interface{})["floor_id"]; got != floorID {
+			t.Errorf("expected floor %d to be %s, got %v", i, floorID, got)
+		}
+	}
+
+	ground := result[0].(map[string]interface{})
+	areaIDs := ground["area_ids"].([]string)
+	if len(areaIDs) != 2 || areaIDs[0] != "kitchen" || areaIDs[1] != "living_room" {
+		t.Errorf("expected sorted areas [kitchen living_room], got %v", areaIDs)
+	}
+	if ground["has_level"] != true || ground["level"] != 0 {
+		t.Errorf("expected level 0, got %v (has_level %v)", ground["level"], ground["has_level"])
+	}
+
+	attic := result[2].(map[string]interface{})
+	if attic["has_level"] != false {
+		t.Error("expected attic to have no level")
+	}
+	if len(attic["area_ids"].([]string)) != 0 {
+		t.Errorf("expected no areas on the attic, got %v", attic["area_ids"])
+	}
+}
+
+// Acceptance tests - require a real Home Assistant instance
+
+func TestAccDataSourceFloors_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceFloorsConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.homeassistant_floors.all", "floors.*", map[string]string{
+						"floor_id":   "terraform_test_floor",
+						"area_ids.#": "1",
+						"area_ids.0": "terraform_test_area",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceFloorsConfig_basic() string {
+	return `
+resource "homeassistant_floor" "test" {
+  name = "Terraform Test Floor"
+}
+
+resource "homeassistant_area" "test" {
+  name     = "Terraform Test Area"
+  floor_id = homeassistant_floor.test.floor_id
+}
+
+data "homeassistant_floors" "all" {
+  depends_on = [homeassistant_area.test]
+}
+`
+}
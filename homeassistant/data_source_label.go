@@ -0,0 +1,91 @@
+package homeassistant
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/dawwestk/terraform-provider-homeassistant/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceLabel() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLabelRead,
+
+		Schema: map[string]*schema.Schema{
+			"label_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"label_id", "name"},
+				Description:  "ID of the label to look up (e.g., guest_mode).",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"label_id", "name"},
+				Description:  "Name of the label to look up. Matched case-insensitively.",
+			},
+			"color": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Color of the label.",
+			},
+			"icon": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "MDI icon for the label.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Description of the label.",
+			},
+		},
+	}
+}
+
+func dataSourceLabelRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	var diags diag.Diagnostics
+
+	labels, err := c.GetLabelsContext(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	label, err := findLabel(labels, d.Get("label_id").(string), d.Get("name").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(label.LabelID)
+	d.Set("label_id", label.LabelID)
+	d.Set("name", label.Name)
+	d.Set("color", label.Color)
+	d.Set("icon", label.Icon)
+	d.Set("description", label.Description)
+
+	return diags
+}
+
+// findLabel returns the label with the given ID, or else the one with the given name.
+func findLabel(labels []client.Label, labelID, name string) (*client.Label, error) {
+	for i := range labels {
+		if labelID != "" && labels[i].LabelID == labelID {
+			return &labels[i], nil
+		}
+		if labelID == "" && strings.EqualFold(labels[i].Name, name) {
+			return &labels[i], nil
+		}
+	}
+
+	if labelID != "" {
+		return nil, fmt.Errorf("no label with ID %q", labelID)
+	}
+	return nil, fmt.Errorf("no label named %q", name)
+}
@@ -0,0 +1,75 @@
+package homeassistant
+
+import (
+	"testing"
+
+	"github.com/dawwestk/terraform-provider-homeassistant/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestDataSourceLabel_Schema(t *testing.T) {
+	s := dataSourceLabel().Schema
+
+	// Test lookup fields
+	lookupFields := []string{"label_id", "name"}
+	for _, field := range lookupFields {
+		if !s[field].Optional || !s[field].Computed {
+			t.Errorf("expected %s to be optional and computed", field)
+		}
+	}
+
+	// Test computed fields
+	computedFields := []string{"color", "icon", "description"}
+	for _, field := range computedFields {
+		if !s[field].Computed {
+			t.Errorf("expected %s to be computed", field)
+		}
+	}
+}
+
+func TestFindLabel(t *testing.T) {
+	labels := []client.Label{
+		{LabelID: "critical", Name: "Critical"},
+		{LabelID: "guest_mode", Name: "Guest mode"},
+	}
+
+	label, err := findLabel(labels, "", "GUEST MODE")
+	if err != nil || label.LabelID != "guest_mode" {
+		t.Errorf("expected guest_mode by case-insensitive name, got %v, %v", label, err)
+	}
+
+	label, err = findLabel(labels, "critical", "")
+	if err != nil || label.Name != "Critical" {
+		t.Errorf("expected Critical by ID, got %v, %v", label, err)
+	}
+
+	if _, err := findLabel(labels, "", "Holiday"); err == nil {
+		t.Error("expected error for unknown label name")
+	}
+}
+
+// Acceptance tests - require a real Home Assistant instance
+
+func TestAccDataSourceLabel_byName(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceLabelConfig_byName(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.homeassistant_label.test", "label_id", "homeassistant_label.test", "label_id"),
+					resource.TestCheckResourceAttr("data.homeassistant_label.test", "color", "red"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceLabelConfig_byName() string {
+	return testAccResourceLabelConfig_basic("red") + `
+data "homeassistant_label" "test" {
+  name = homeassistant_label.test.name
+}
+`
+}
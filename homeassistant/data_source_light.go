@@ -86,7 +86,7 @@ func dataSourceLightRead(ctx context.Context, d *schema.ResourceData, m interfac
 
 	entityID := d.Get("entity_id").(string)
 
-	state, err := c.GetState(entityID)
+	state, err := c.GetStateContext(ctx, entityID)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to read light state: %w", err))
 	}
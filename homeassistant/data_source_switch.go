@@ -0,0 +1,93 @@
+package homeassistant
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dawwestk/terraform-provider-homeassistant/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceSwitch() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSwitchRead,
+
+		Schema: map[string]*schema.Schema{
+			"entity_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The entity ID of the switch (e.g., switch.coffee_machine).",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Current state of the switch ('on' or 'off').",
+			},
+			"friendly_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Friendly name of the switch.",
+			},
+			"device_class": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Device class of the switch (e.g., outlet or switch).",
+			},
+			"icon": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "MDI icon of the switch, if set.",
+			},
+			"last_changed": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Timestamp of the last state change.",
+			},
+			"last_updated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Timestamp of the last update.",
+			},
+		},
+	}
+}
+
+func dataSourceSwitchRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	var diags diag.Diagnostics
+
+	entityID := d.Get("entity_id").(string)
+
+	state, err := c.GetStateContext(ctx, entityID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read switch state: %w", err))
+	}
+
+	d.SetId(entityID)
+	d.Set("state", state.State)
+	d.Set("last_changed", state.LastChanged)
+	d.Set("last_updated", state.LastUpdated)
+
+	// Extract attributes
+	if friendlyName, ok := state.Attributes["friendly_name"]; ok {
+		if fn, ok := friendlyName.(string); ok {
+			d.Set("friendly_name", fn)
+		}
+	}
+
+	if deviceClass, ok := state.Attributes["device_class"]; ok {
+		if dc, ok := deviceClass.(string); ok {
+			d.Set("device_class", dc)
+		}
+	}
+
+	if icon, ok := state.Attributes["icon"]; ok {
+		if i, ok := icon.(string); ok {
+			d.Set("icon", i)
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,55 @@
+package homeassistant
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestDataSourceSwitch_Schema(t *testing.T) {
+	s := dataSourceSwitch().Schema
+
+	// Test required field
+	if !s["entity_id"].Required {
+		t.Error("expected entity_id to be required")
+	}
+
+	// Test computed fields
+	computedFields := []string{
+		"state", "friendly_name", "device_class", "icon",
+		"last_changed", "last_updated",
+	}
+	for _, field := range computedFields {
+		if !s[field].Computed {
+			t.Errorf("expected %s to be computed", field)
+		}
+	}
+}
+
+// Acceptance tests - require a real Home Assistant instance
+
+func TestAccDataSourceSwitch_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccSwitchPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSwitchConfig_basic(getTestSwitchEntityID()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.homeassistant_switch.test", "state"),
+					resource.TestCheckResourceAttrSet("data.homeassistant_switch.test", "friendly_name"),
+					resource.TestCheckResourceAttrSet("data.homeassistant_switch.test", "last_changed"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSwitchConfig_basic(entityID string) string {
+	return fmt.Sprintf(`
+data "homeassistant_switch" "test" {
+  entity_id = %q
+}
+`, entityID)
+}
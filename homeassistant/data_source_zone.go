@@ -88,7 +88,7 @@ func dataSourceZoneRead(ctx context.Context, d *schema.ResourceData, m interface
 
 	entityID := d.Get("entity_id").(string)
 
-	state, err := c.GetState(entityID)
+	state, err := c.GetStateContext(ctx, entityID)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to read zone state: %w", err))
 	}
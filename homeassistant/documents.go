@@ -0,0 +1,130 @@
+package homeassistant
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gopkg.in/yaml.v3"
+)
+
+// Documents are attributes holding YAML or JSON, such as the triggers of an
+// automation. JSON is valid YAML, so both are parsed by the YAML decoder and
+// compared by value rather than by formatting.
+
+// parseDocument decodes a YAML or JSON document into plain JSON values:
+// maps, slices, strings, float64 numbers, booleans and nil. An empty
+// document decodes to nil.
+func parseDocument(s string) (interface{}, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+
+	var v interface{}
+	if err := yaml.Unmarshal([]byte(s), &v); err != nil {
+		return nil, err
+	}
+
+	return normalizeDocument(v)
+}
+
+// normalizeDocument converts decoded YAML or JSON values to the types
+// produced by encoding/json, so that equal documents are deeply equal.
+func normalizeDocument(v interface{}) (interface{}, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var normalized interface{}
+	if err := json.Unmarshal(raw, &normalized); err != nil {
+		return nil, err
+	}
+
+	// An empty list or map means the same as no value at all
+	switch n := normalized.(type) {
+	case []interface{}:
+		if len(n) == 0 {
+			return nil, nil
+		}
+	case map[string]interface{}:
+		if len(n) == 0 {
+			return nil, nil
+		}
+	}
+
+	return normalized, nil
+}
+
+// formatDocument encodes a value as YAML with two-space indentation.
+func formatDocument(v interface{}) (string, error) {
+	if v == nil {
+		return "", nil
+	}
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(v); err != nil {
+		return "", err
+	}
+	if err := enc.Close(); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+// documentEquivalent reports whether the document s holds the same value as v.
+func documentEquivalent(s string, v interface{}) bool {
+	parsed, err := parseDocument(s)
+	if err != nil {
+		return false
+	}
+
+	normalized, err := normalizeDocument(v)
+	if err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(parsed, normalized)
+}
+
+// documentValue returns the current document in state if it is equivalent
+// to the remote value v, so that formatting chosen in the configuration is
+// kept, and the remote value formatted as YAML otherwise.
+func documentValue(current string, v interface{}) (string, error) {
+	if documentEquivalent(current, v) {
+		return current, nil
+	}
+
+	return formatDocument(v)
+}
+
+// validateDocument checks that a value is a well-formed YAML or JSON document.
+func validateDocument(v interface{}, k string) ([]string, []error) {
+	s, ok := v.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected %s to be a string", k)}
+	}
+
+	if _, err := parseDocument(s); err != nil {
+		return nil, []error{fmt.Errorf("%s is not valid YAML or JSON: %w", k, err)}
+	}
+
+	return nil, nil
+}
+
+// suppressEquivalentDocument suppresses diffs between documents that only
+// differ in formatting, key order or choice of YAML or JSON.
+func suppressEquivalentDocument(k, old, new string, d *schema.ResourceData) bool {
+	parsed, err := parseDocument(new)
+	if err != nil {
+		return false
+	}
+
+	return documentEquivalent(old, parsed)
+}
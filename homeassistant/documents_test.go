@@ -0,0 +1,108 @@
+package homeassistant
+
+import (
+	"testing"
+)
+
+func TestSuppressEquivalentDocument(t *testing.T) {
+	tests := []struct {
+		name     string
+		old      string
+		new      string
+		expected bool
+	}{
+		{
+			name:     "identical",
+			old:      "- trigger: sun\n  event: sunset\n",
+			new:      "- trigger: sun\n  event: sunset\n",
+			expected: true,
+		},
+		{
+			name:     "key order and indentation",
+			old:      "- trigger: sun\n  event: sunset\n",
+			new:      "-   event: sunset\n    trigger: sun\n",
+			expected: true,
+		},
+		{
+			name:     "YAML and JSON",
+			old:      "- trigger: sun\n  event: sunset\n  offset: -30\n",
+			new:      `[{"trigger": "sun", "event": "sunset", "offset": -30}]`,
+			expected: true,
+		},
+		{
+			name:     "empty and empty list",
+			old:      "",
+			new:      "[]",
+			expected: true,
+		},
+		{
+			name:     "empty and empty map",
+			old:      "",
+			new:      "{}",
+			expected: true,
+		},
+		{
+			name:     "different value",
+			old:      "- trigger: sun\n  event: sunset\n",
+			new:      "- trigger: sun\n  event: sunrise\n",
+			expected: false,
+		},
+		{
+			name:     "number and string",
+			old:      "- delay: 5\n",
+			new:      "- delay: \"5\"\n",
+			expected: false,
+		},
+		{
+			name:     "invalid new document",
+			old:      "- trigger: sun\n",
+			new:      "- trigger: [sun\n",
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := suppressEquivalentDocument("triggers", tt.old, tt.new, nil); got != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestValidateDocument(t *testing.T) {
+	if _, errs := validateDocument("- service: light.turn_on\n", "actions"); len(errs) > 0 {
+		t.Errorf("expected valid YAML, got %v", errs)
+	}
+	if _, errs := validateDocument(`[{"service": "light.turn_on"}]`, "actions"); len(errs) > 0 {
+		t.Errorf("expected valid JSON, got %v", errs)
+	}
+	if _, errs := validateDocument("- service: [light.turn_on\n", "actions"); len(errs) == 0 {
+		t.Error("expected error for malformed document")
+	}
+}
+
+func TestDocumentValue(t *testing.T) {
+	remote := []interface{}{
+		map[string]interface{}{"trigger": "sun", "event": "sunset"},
+	}
+
+	// Equivalent documents keep the configured formatting
+	current := `[{"event": "sunset", "trigger": "sun"}]`
+	got, err := documentValue(current, remote)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got != current {
+		t.Errorf("expected current document to be kept, got %q", got)
+	}
+
+	// Drift is reported as YAML
+	got, err = documentValue("- trigger: sun\n  event: sunrise\n", remote)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got != "- event: sunset\n  trigger: sun\n" {
+		t.Errorf("expected remote document as YAML, got %q", got)
+	}
+}
@@ -0,0 +1,260 @@
+package homeassistant
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dawwestk/terraform-provider-homeassistant/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// helperEntityID resolves the entity ID of a zone or helper from its
+// collection id. These entities are registered with the collection id as their
+// unique ID, and their entity ID starts out as the id but may be renamed by the
+// user. The id is only assumed while the entity is not registered yet.
+func helperEntityID(ctx context.Context, c *client.Client, domain, id string) (string, error) {
+	entityID, err := c.FindEntityIDContext(ctx, domain, id)
+	if err != nil {
+		if client.IsNotFound(err) {
+			return domain + "." + id, nil
+		}
+		return "", fmt.Errorf("failed to resolve %s entity ID: %w", domain, err)
+	}
+	return entityID, nil
+}
+
+// importHelper returns an importer that accepts either the collection id of a
+// zone or helper or its entity ID (e.g., input_boolean.guest_mode), resolving
+// the latter through the entity registry.
+func importHelper(domain string) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		c := m.(*client.Client)
+
+		id := d.Id()
+
+		if strings.HasPrefix(id, domain+".") {
+			entry, err := c.GetEntityRegistryEntryContext(ctx, id)
+			if err != nil {
+				return nil, fmt.Errorf("failed to look up %s: %w", id, err)
+			}
+			if entry.Platform != domain || entry.UniqueID == "" {
+				return nil, fmt.Errorf("%s is not managed by the %s storage collection and cannot be imported", id, domain)
+			}
+			d.SetId(entry.UniqueID)
+			d.Set("entity_id", id)
+		}
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+// helperCollection describes a helper that is managed through the generic
+// commands of its storage collection. resource builds the Terraform resource
+// from it, adding the name, icon and entity_id attributes every helper has.
+type helperCollection struct {
+	// Domain is both the storage collection and the entity domain, e.g. counter.
+	Domain string
+
+	// Noun names the helper in descriptions and error messages, e.g. "counter".
+	Noun string
+
+	// IconExample is an icon shown in the description of the icon attribute.
+	IconExample string
+
+	// Schema holds the attributes specific to the helper.
+	Schema map[string]*schema.Schema
+
+	// Expand adds the helper specific attributes to a create or update payload.
+	Expand func(d *schema.ResourceData, payload map[string]interface{})
+
+	// Flatten sets the helper specific attributes from a stored item.
+	Flatten func(d *schema.ResourceData, item map[string]interface{})
+
+	// AfterWrite optionally applies attributes that are not stored in the
+	// collection, such as the current value, once the helper is created or
+	// updated and its entity ID is known.
+	AfterWrite func(ctx context.Context, c *client.Client, d *schema.ResourceData) error
+
+	// FlattenState optionally sets attributes from the current state of the
+	// helper entity. It is skipped while the entity has not been added yet.
+	FlattenState func(d *schema.ResourceData, state *client.State)
+
+	// CustomizeDiff optionally validates the configuration at plan time.
+	CustomizeDiff schema.CustomizeDiffFunc
+}
+
+func (h helperCollection) resource() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			Description:  fmt.Sprintf("Friendly name of the %s.", h.Noun),
+		},
+		"icon": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: fmt.Sprintf("MDI icon for the %s (e.g., %s). Home Assistant keeps the current icon when removed from the configuration.", h.Noun, h.IconExample),
+		},
+		// Computed attributes
+		"entity_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("The entity ID of the %s (e.g., %s.kitchen).", h.Noun, h.Domain),
+		},
+	}
+	for key, attr := range h.Schema {
+		s[key] = attr
+	}
+
+	return &schema.Resource{
+		CreateContext: h.create,
+		ReadContext:   h.read,
+		UpdateContext: h.update,
+		DeleteContext: h.delete,
+
+		CustomizeDiff: h.CustomizeDiff,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: importHelper(h.Domain),
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: s,
+	}
+}
+
+// payload builds a create or update payload from the resource data.
+func (h helperCollection) payload(d *schema.ResourceData) map[string]interface{} {
+	payload := map[string]interface{}{
+		"name": d.Get("name").(string),
+	}
+	if icon := d.Get("icon").(string); icon != "" {
+		payload["icon"] = icon
+	}
+
+	if h.Expand != nil {
+		h.Expand(d, payload)
+	}
+
+	return payload
+}
+
+func (h helperCollection) create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	var created struct {
+		ID string `json:"id"`
+	}
+	if err := c.CreateCollectionItemContext(ctx, h.Domain, h.payload(d), &created); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to create %s: %w", h.Noun, err))
+	}
+
+	d.SetId(created.ID)
+	entityID, err := helperEntityID(ctx, c, h.Domain, created.ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("entity_id", entityID)
+
+	if h.AfterWrite != nil {
+		if err := h.AfterWrite(ctx, c, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return h.read(ctx, d, m)
+}
+
+func (h helperCollection) read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	var diags diag.Diagnostics
+
+	item, err := c.GetCollectionItemContext(ctx, h.Domain, d.Id())
+	if err != nil {
+		if client.IsNotFound(err) {
+			// The helper was deleted outside of Terraform
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(fmt.Errorf("failed to read %s: %w", h.Noun, err))
+	}
+
+	name, _ := item["name"].(string)
+	icon, _ := item["icon"].(string)
+	d.Set("name", name)
+	d.Set("icon", icon)
+
+	if h.Flatten != nil {
+		h.Flatten(d, item)
+	}
+
+	if d.Get("entity_id").(string) == "" {
+		entityID, err := helperEntityID(ctx, c, h.Domain, d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.Set("entity_id", entityID)
+	}
+
+	if h.FlattenState != nil {
+		// The entity may not have been added yet right after creation
+		state, err := c.GetStateContext(ctx, d.Get("entity_id").(string))
+		if err != nil && !client.IsNotFound(err) {
+			return diag.FromErr(fmt.Errorf("failed to read %s state: %w", h.Noun, err))
+		}
+		if err == nil {
+			h.FlattenState(d, state)
+		}
+	}
+
+	return diags
+}
+
+func (h helperCollection) update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	if err := c.UpdateCollectionItemContext(ctx, h.Domain, d.Id(), h.payload(d), nil); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to update %s: %w", h.Noun, err))
+	}
+
+	if h.AfterWrite != nil {
+		if err := h.AfterWrite(ctx, c, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return h.read(ctx, d, m)
+}
+
+func (h helperCollection) delete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	var diags diag.Diagnostics
+
+	err := c.DeleteCollectionItemContext(ctx, h.Domain, d.Id())
+	if err != nil && !client.IsNotFound(err) {
+		return diag.FromErr(fmt.Errorf("failed to delete %s: %w", h.Noun, err))
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// itemInt returns a whole number from a stored helper item, and whether it is set.
+func itemInt(item map[string]interface{}, key string) (int, bool) {
+	f, ok := toFloat(item[key])
+	return int(f), ok
+}
@@ -0,0 +1,64 @@
+package homeassistant
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestHelperCollection_Resource(t *testing.T) {
+	r := helperCollection{
+		Domain:      "input_button",
+		Noun:        "input button",
+		IconExample: "mdi:gesture-tap-button",
+		Schema: map[string]*schema.Schema{
+			"restore": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+		},
+	}.resource()
+
+	if !r.Schema["name"].Required {
+		t.Error("expected name to be required")
+	}
+	if !r.Schema["icon"].Optional || !r.Schema["icon"].Computed {
+		t.Error("expected icon to be optional and computed")
+	}
+	if !r.Schema["entity_id"].Computed {
+		t.Error("expected entity_id to be computed")
+	}
+	if _, ok := r.Schema["restore"]; !ok {
+		t.Error("expected the helper specific schema to be included")
+	}
+	if r.Importer == nil {
+		t.Error("expected resource to have an importer")
+	}
+	if err := r.InternalValidate(nil, true); err != nil {
+		t.Errorf("expected a valid resource, got %v", err)
+	}
+}
+
+func TestHelperCollection_Payload(t *testing.T) {
+	h := helperCollection{
+		Domain: "timer",
+		Noun:   "timer",
+		Schema: resourceTimer().Schema,
+		Expand: expandTimer,
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceTimer().Schema, map[string]interface{}{
+		"name":     "Laundry",
+		"duration": "1:30:00",
+	})
+
+	expected := map[string]interface{}{
+		"name":     "Laundry",
+		"duration": "1:30:00",
+		"restore":  false,
+	}
+	if payload := h.payload(d); !reflect.DeepEqual(payload, expected) {
+		t.Errorf("expected payload %v, got %v", expected, payload)
+	}
+}
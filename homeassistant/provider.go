@@ -3,11 +3,15 @@ package homeassistant
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/dawwestk/terraform-provider-homeassistant/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 // Provider returns the Home Assistant Terraform provider.
@@ -25,7 +29,7 @@ func Provider() *schema.Provider {
 				Type:        schema.TypeString,
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("HA_HOST_NAME", nil),
-				Description: "IP address or hostname of the Home Assistant instance. Can also be set via HA_HOST_NAME env var.",
+				Description: "IP address or hostname of the Home Assistant instance. Ignored when url is set. Can also be set via HA_HOST_NAME env var.",
 			},
 			"port": {
 				Type:        schema.TypeString,
@@ -33,14 +37,102 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("HA_PORT", "8123"),
 				Description: "Port of the Home Assistant instance. Defaults to 8123. Can also be set via HA_PORT env var.",
 			},
+			"url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("HA_URL", nil),
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+				Description:  "Full URL of the Home Assistant instance, including any path prefix (e.g., https://ha.example.com/). Supersedes host_name and port. Can also be set via HA_URL env var.",
+			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Number of times a request failing with a connection error or a 429/502/503/504 status is retried. Defaults to 3. Set to 0 to disable retries.",
+			},
+			"retry_wait_max": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Maximum time in seconds to wait between retries. Defaults to 30.",
+			},
+			"insecure_skip_verify": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Skip verification of the server's TLS certificate. Not recommended outside of testing.",
+			},
+			"ca_cert_pem": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"ca_cert_file"},
+				Description:   "PEM encoded CA certificate(s) used to verify the server certificate.",
+			},
+			"ca_cert_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"ca_cert_pem"},
+				Description:   "Path to a PEM encoded CA certificate file used to verify the server certificate.",
+			},
+			"client_cert_pem": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"client_cert_file"},
+				Description:   "PEM encoded client certificate presented for mutual TLS.",
+			},
+			"client_cert_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"client_cert_pem"},
+				Description:   "Path to a PEM encoded client certificate presented for mutual TLS.",
+			},
+			"client_key_pem": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"client_key_file"},
+				Description:   "PEM encoded private key for the client certificate.",
+			},
+			"client_key_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"client_key_pem"},
+				Description:   "Path to a PEM encoded private key for the client certificate.",
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"homeassistant_light": resourceLight(),
-			"homeassistant_zone":  resourceZone(),
+			"homeassistant_area":            resourceArea(),
+			"homeassistant_automation":      resourceAutomation(),
+			"homeassistant_counter":         resourceCounter(),
+			"homeassistant_device":          resourceDevice(),
+			"homeassistant_entity_registry": resourceEntityRegistry(),
+			"homeassistant_floor":           resourceFloor(),
+			"homeassistant_input_boolean":   resourceInputBoolean(),
+			"homeassistant_input_button":    resourceInputButton(),
+			"homeassistant_input_datetime":  resourceInputDatetime(),
+			"homeassistant_input_number":    resourceInputNumber(),
+			"homeassistant_input_select":    resourceInputSelect(),
+			"homeassistant_input_text":      resourceInputText(),
+			"homeassistant_label":           resourceLabel(),
+			"homeassistant_light":           resourceLight(),
+			"homeassistant_scene":           resourceScene(),
+			"homeassistant_schedule":        resourceSchedule(),
+			"homeassistant_script":          resourceScript(),
+			"homeassistant_switch":          resourceSwitch(),
+			"homeassistant_timer":           resourceTimer(),
+			"homeassistant_zone":            resourceZone(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"homeassistant_light": dataSourceLight(),
-			"homeassistant_zone":  dataSourceZone(),
+			"homeassistant_area":     dataSourceArea(),
+			"homeassistant_devices":  dataSourceDevices(),
+			"homeassistant_entities": dataSourceEntities(),
+			"homeassistant_entity":   dataSourceEntity(),
+			"homeassistant_floors":   dataSourceFloors(),
+			"homeassistant_label":    dataSourceLabel(),
+			"homeassistant_light":    dataSourceLight(),
+			"homeassistant_switch":   dataSourceSwitch(),
+			"homeassistant_zone":     dataSourceZone(),
 		},
 		ConfigureContextFunc: providerConfigure,
 	}
@@ -50,6 +142,7 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 	var diags diag.Diagnostics
 
 	token := d.Get("bearer_token").(string)
+	rawURL := d.Get("url").(string)
 	hostName := d.Get("host_name").(string)
 	port := d.Get("port").(string)
 
@@ -57,25 +150,113 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 		return nil, diag.FromErr(fmt.Errorf("bearer_token is required (set via provider config or HA_BEARER_TOKEN env var)"))
 	}
 
-	if hostName == "" {
-		return nil, diag.FromErr(fmt.Errorf("host_name is required (set via provider config or HA_HOST_NAME env var)"))
+	if rawURL == "" && hostName == "" {
+		return nil, diag.FromErr(fmt.Errorf("url or host_name is required (set via provider config or HA_URL / HA_HOST_NAME env vars)"))
+	}
+
+	baseURL, err := providerBaseURL(rawURL, hostName, port)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	tlsOpts, err := providerTLSOptions(d)
+	if err != nil {
+		return nil, diag.FromErr(err)
 	}
 
-	// Set environment variables for the client
-	os.Setenv("HA_BEARER_TOKEN", token)
-	os.Setenv("HA_HOST_NAME", hostName)
-	os.Setenv("HA_PORT", port)
+	// Build the client from this provider block's configuration only, so that
+	// aliased provider blocks can target different instances.
+	retry := client.DefaultRetryPolicy()
+	retry.MaxRetries = d.Get("max_retries").(int)
+	retry.WaitMax = time.Duration(d.Get("retry_wait_max").(int)) * time.Second
+	if retry.WaitMin > retry.WaitMax {
+		retry.WaitMin = retry.WaitMax
+	}
 
-	c, err := client.NewClient()
+	c, err := client.New(client.Options{
+		BaseURL: baseURL,
+		Token:   token,
+		Retry:   &retry,
+		TLS:     tlsOpts,
+	})
 	if err != nil {
 		return nil, diag.FromErr(fmt.Errorf("failed to create Home Assistant client: %w", err))
 	}
 
 	// Verify connectivity
-	_, err = c.Health()
+	_, err = c.HealthContext(ctx)
 	if err != nil {
 		return nil, diag.FromErr(fmt.Errorf("failed to connect to Home Assistant API: %w", err))
 	}
 
 	return c, diags
 }
+
+// providerBaseURL returns the REST API base URL. A full url takes precedence
+// over host_name and port, and has /api appended unless already present.
+func providerBaseURL(rawURL, hostName, port string) (string, error) {
+	if rawURL == "" {
+		return fmt.Sprintf("http://%s:%s/api", hostName, port), nil
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid url %q: %w", rawURL, err)
+	}
+
+	u.Path = strings.TrimSuffix(u.Path, "/")
+	if !strings.HasSuffix(u.Path, "/api") {
+		u.Path += "/api"
+	}
+
+	return u.String(), nil
+}
+
+// providerTLSOptions collects the TLS settings, reading certificate files
+// where a path was given instead of inline PEM. Returns nil when none are set.
+func providerTLSOptions(d *schema.ResourceData) (*client.TLSOptions, error) {
+	caCert, err := pemOrFile(d, "ca_cert_pem", "ca_cert_file")
+	if err != nil {
+		return nil, err
+	}
+
+	clientCert, err := pemOrFile(d, "client_cert_pem", "client_cert_file")
+	if err != nil {
+		return nil, err
+	}
+
+	clientKey, err := pemOrFile(d, "client_key_pem", "client_key_file")
+	if err != nil {
+		return nil, err
+	}
+
+	insecure := d.Get("insecure_skip_verify").(bool)
+
+	if !insecure && caCert == nil && clientCert == nil && clientKey == nil {
+		return nil, nil
+	}
+
+	return &client.TLSOptions{
+		InsecureSkipVerify: insecure,
+		CACertPEM:          caCert,
+		ClientCertPEM:      clientCert,
+		ClientKeyPEM:       clientKey,
+	}, nil
+}
+
+// pemOrFile returns the inline PEM attribute, or the contents of the file attribute.
+func pemOrFile(d *schema.ResourceData, pemKey, fileKey string) ([]byte, error) {
+	if v := d.Get(pemKey).(string); v != "" {
+		return []byte(v), nil
+	}
+
+	if path := d.Get(fileKey).(string); path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", fileKey, err)
+		}
+		return data, nil
+	}
+
+	return nil, nil
+}
@@ -1,9 +1,17 @@
 package homeassistant
 
 import (
+	"context"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/dawwestk/terraform-provider-homeassistant/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -34,7 +42,25 @@ func TestProvider_impl(t *testing.T) {
 
 func TestProvider_HasExpectedResources(t *testing.T) {
 	expectedResources := []string{
+		"homeassistant_area",
+		"homeassistant_automation",
+		"homeassistant_counter",
+		"homeassistant_device",
+		"homeassistant_entity_registry",
+		"homeassistant_floor",
+		"homeassistant_input_boolean",
+		"homeassistant_input_button",
+		"homeassistant_input_datetime",
+		"homeassistant_input_number",
+		"homeassistant_input_select",
+		"homeassistant_input_text",
+		"homeassistant_label",
 		"homeassistant_light",
+		"homeassistant_scene",
+		"homeassistant_schedule",
+		"homeassistant_script",
+		"homeassistant_switch",
+		"homeassistant_timer",
 		"homeassistant_zone",
 	}
 
@@ -48,7 +74,14 @@ func TestProvider_HasExpectedResources(t *testing.T) {
 
 func TestProvider_HasExpectedDataSources(t *testing.T) {
 	expectedDataSources := []string{
+		"homeassistant_area",
+		"homeassistant_devices",
+		"homeassistant_entities",
+		"homeassistant_entity",
+		"homeassistant_floors",
+		"homeassistant_label",
 		"homeassistant_light",
+		"homeassistant_switch",
 		"homeassistant_zone",
 	}
 
@@ -65,6 +98,16 @@ func TestProvider_SchemaHasExpectedAttributes(t *testing.T) {
 		"bearer_token",
 		"host_name",
 		"port",
+		"url",
+		"max_retries",
+		"retry_wait_max",
+		"insecure_skip_verify",
+		"ca_cert_pem",
+		"ca_cert_file",
+		"client_cert_pem",
+		"client_cert_file",
+		"client_key_pem",
+		"client_key_file",
 	}
 
 	provider := Provider()
@@ -82,6 +125,127 @@ func TestProvider_BearerTokenIsSensitive(t *testing.T) {
 	}
 }
 
+func TestProvider_ClientKeyIsSensitive(t *testing.T) {
+	provider := Provider()
+	if !provider.Schema["client_key_pem"].Sensitive {
+		t.Error("expected client_key_pem to be marked as sensitive")
+	}
+}
+
+func TestProviderConfigure_RetryPolicy(t *testing.T) {
+	server := newTestHAServer(t, "test-token")
+	u, _ := url.Parse(server.URL)
+
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"bearer_token":   "test-token",
+		"host_name":      u.Hostname(),
+		"port":           u.Port(),
+		"max_retries":    5,
+		"retry_wait_max": 10,
+	})
+
+	meta, diags := providerConfigure(context.Background(), d)
+	if diags.HasError() {
+		t.Fatalf("unexpected error configuring provider: %v", diags)
+	}
+
+	c := meta.(*client.Client)
+	if c.Retry.MaxRetries != 5 {
+		t.Errorf("expected MaxRetries 5, got %d", c.Retry.MaxRetries)
+	}
+	if c.Retry.WaitMax != 10*time.Second {
+		t.Errorf("expected WaitMax 10s, got %s", c.Retry.WaitMax)
+	}
+}
+
+func TestProviderBaseURL(t *testing.T) {
+	tests := []struct {
+		url      string
+		hostName string
+		port     string
+		expected string
+	}{
+		{"", "192.168.1.100", "8123", "http://192.168.1.100:8123/api"},
+		{"https://ha.example.com/", "ignored", "8123", "https://ha.example.com/api"},
+		{"https://ha.example.com", "", "8123", "https://ha.example.com/api"},
+		{"https://example.ui.nabu.casa/prefix", "", "8123", "https://example.ui.nabu.casa/prefix/api"},
+		{"http://homeassistant.local:8123/api", "", "8123", "http://homeassistant.local:8123/api"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			result, err := providerBaseURL(tt.url, tt.hostName, tt.port)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("providerBaseURL(%q) = %q, expected %q", tt.url, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestProviderConfigure_URLWithCACert(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/prefix/api/" {
+			t.Errorf("expected path '/prefix/api/', got %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"message": "API running."}`))
+	}))
+	defer server.Close()
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := os.WriteFile(caFile, caPEM, 0o600); err != nil {
+		t.Fatalf("failed to write CA file: %v", err)
+	}
+
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"bearer_token": "test-token",
+		"url":          server.URL + "/prefix/",
+		"ca_cert_file": caFile,
+	})
+
+	meta, diags := providerConfigure(context.Background(), d)
+	if diags.HasError() {
+		t.Fatalf("unexpected error configuring provider: %v", diags)
+	}
+
+	c := meta.(*client.Client)
+	if c.BaseURL != server.URL+"/prefix/api" {
+		t.Errorf("expected BaseURL %s/prefix/api, got %s", server.URL, c.BaseURL)
+	}
+}
+
+func TestProviderConfigure_UntrustedCertificate(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"message": "API running."}`))
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"bearer_token": "test-token",
+		"url":          server.URL,
+	})
+
+	_, diags := providerConfigure(context.Background(), d)
+	if !diags.HasError() {
+		t.Fatal("expected certificate verification error")
+	}
+
+	d = schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"bearer_token":         "test-token",
+		"url":                  server.URL,
+		"insecure_skip_verify": true,
+	})
+
+	_, diags = providerConfigure(context.Background(), d)
+	if diags.HasError() {
+		t.Fatalf("expected insecure_skip_verify to accept the certificate, got %v", diags)
+	}
+}
+
 func TestProvider_PortHasDefault(t *testing.T) {
 	provider := Provider()
 	portSchema := provider.Schema["port"]
@@ -99,13 +263,85 @@ func TestProvider_PortHasDefault(t *testing.T) {
 	}
 }
 
+// newTestHAServer starts a fake Home Assistant API that only accepts the given token.
+func newTestHAServer(t *testing.T, token string) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+token {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"message": "API running."}`))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+// testClient returns a client pointing at a test server
+func testClient(server *httptest.Server) *client.Client {
+	return &client.Client{
+		BaseURL:    server.URL,
+		Token:      "test-token",
+		HTTPClient: server.Client(),
+	}
+}
+
+// testProviderClient configures a provider against a test server and returns its client.
+func testProviderClient(t *testing.T, server *httptest.Server, token string) *client.Client {
+	t.Helper()
+
+	u, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatalf("failed to parse server URL: %v", err)
+	}
+
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"bearer_token": token,
+		"host_name":    u.Hostname(),
+		"port":         u.Port(),
+	})
+
+	meta, diags := providerConfigure(context.Background(), d)
+	if diags.HasError() {
+		t.Fatalf("unexpected error configuring provider: %v", diags)
+	}
+
+	return meta.(*client.Client)
+}
+
+func TestProviderConfigure_MultipleInstances(t *testing.T) {
+	home := newTestHAServer(t, "home-token")
+	office := newTestHAServer(t, "office-token")
+
+	homeClient := testProviderClient(t, home, "home-token")
+	officeClient := testProviderClient(t, office, "office-token")
+
+	if homeClient.BaseURL == officeClient.BaseURL {
+		t.Errorf("expected distinct base URLs, both were %s", homeClient.BaseURL)
+	}
+	if homeClient.Token != "home-token" {
+		t.Errorf("expected home client token 'home-token', got %s", homeClient.Token)
+	}
+
+	// The first client must still reach its own instance after the second is configured
+	if _, err := homeClient.Health(); err != nil {
+		t.Errorf("expected home client to remain usable, got %v", err)
+	}
+	if _, err := officeClient.Health(); err != nil {
+		t.Errorf("expected office client to be usable, got %v", err)
+	}
+}
+
 // testAccPreCheck validates the necessary test API keys exist
 // in the testing environment
 func testAccPreCheck(t *testing.T) {
 	if v := os.Getenv("HA_BEARER_TOKEN"); v == "" {
 		t.Skip("HA_BEARER_TOKEN must be set for acceptance tests")
 	}
-	if v := os.Getenv("HA_HOST_NAME"); v == "" {
-		t.Skip("HA_HOST_NAME must be set for acceptance tests")
+	if os.Getenv("HA_URL") == "" && os.Getenv("HA_HOST_NAME") == "" {
+		t.Skip("HA_URL or HA_HOST_NAME must be set for acceptance tests")
 	}
 }
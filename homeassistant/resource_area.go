@@ -0,0 +1,154 @@
+package homeassistant
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dawwestk/terraform-provider-homeassistant/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceArea() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAreaCreate,
+		ReadContext:   resourceAreaRead,
+		UpdateContext: resourceAreaUpdate,
+		DeleteContext: resourceAreaDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "Name of the area.",
+			},
+			"aliases": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Alternative names of the area, used by voice assistants.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"icon": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "MDI icon for the area (e.g., mdi:sofa).",
+			},
+			"picture": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "URL of a picture of the area.",
+			},
+			"floor_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ID of the floor the area is on.",
+			},
+			"labels": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "IDs of the labels assigned to the area.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			// Computed attributes
+			"area_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the area, derived from its name at creation (e.g., living_room).",
+			},
+		},
+	}
+}
+
+// areaFromResourceData builds an area registry entry from the resource data.
+func areaFromResourceData(d *schema.ResourceData) client.Area {
+	return client.Area{
+		Name:    d.Get("name").(string),
+		Aliases: expandStringSet(d.Get("aliases").(*schema.Set)),
+		Icon:    d.Get("icon").(string),
+		Picture: d.Get("picture").(string),
+		FloorID: d.Get("floor_id").(string),
+		Labels:  expandStringSet(d.Get("labels").(*schema.Set)),
+	}
+}
+
+func resourceAreaCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	area, err := c.CreateAreaContext(ctx, areaFromResourceData(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(area.AreaID)
+
+	return resourceAreaRead(ctx, d, m)
+}
+
+func resourceAreaRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	var diags diag.Diagnostics
+
+	area, err := c.GetAreaContext(ctx, d.Id())
+	if err != nil {
+		if client.IsNotFound(err) {
+			// The area was deleted outside of Terraform
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(fmt.Errorf("failed to read area: %w", err))
+	}
+
+	d.Set("area_id", area.AreaID)
+	d.Set("name", area.Name)
+	d.Set("aliases", area.Aliases)
+	d.Set("icon", area.Icon)
+	d.Set("picture", area.Picture)
+	d.Set("floor_id", area.FloorID)
+	d.Set("labels", area.Labels)
+
+	return diags
+}
+
+func resourceAreaUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	_, err := c.UpdateAreaContext(ctx, d.Id(), areaFromResourceData(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceAreaRead(ctx, d, m)
+}
+
+func resourceAreaDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	var diags diag.Diagnostics
+
+	err := c.DeleteAreaContext(ctx, d.Id())
+	if err != nil && !client.IsNotFound(err) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,101 @@
+package homeassistant
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceArea_Schema(t *testing.T) {
+	s := resourceArea().Schema
+
+	if !s["name"].Required {
+		t.Error("expected name to be required")
+	}
+
+	// Test optional fields
+	optionalFields := []string{"aliases", "icon", "picture", "floor_id", "labels"}
+	for _, field := range optionalFields {
+		if !s[field].Optional {
+			t.Errorf("expected %s to be optional", field)
+		}
+	}
+
+	if !s["area_id"].Computed {
+		t.Error("expected area_id to be computed")
+	}
+}
+
+func TestResourceArea_HasImporter(t *testing.T) {
+	r := resourceArea()
+	if r.Importer == nil {
+		t.Error("expected resource to have an importer")
+	}
+}
+
+func TestAreaFromResourceData(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceArea().Schema, map[string]interface{}{
+		"name":     "Living Room",
+		"aliases":  []interface{}{"lounge", "front room"},
+		"floor_id": "ground_floor",
+		"labels":   []interface{}{"heating"},
+	})
+
+	area := areaFromResourceData(d)
+
+	if area.Name != "Living Room" || area.FloorID != "ground_floor" {
+		t.Errorf("unexpected area: %+v", area)
+	}
+	if !reflect.DeepEqual(area.Aliases, []string{"front room", "lounge"}) {
+		t.Errorf("expected sorted aliases, got %v", area.Aliases)
+	}
+	if !reflect.DeepEqual(area.Labels, []string{"heating"}) {
+		t.Errorf("expected labels [heating], got %v", area.Labels)
+	}
+	if area.Icon != "" || area.Picture != "" {
+		t.Errorf("expected unset icon and picture to be empty, got %q and %q", area.Icon, area.Picture)
+	}
+}
+
+// Acceptance tests - require a real Home Assistant instance
+
+func TestAccResourceArea_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceAreaConfig_basic("mdi:flask"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("homeassistant_area.test", "name", "Terraform Test Area"),
+					resource.TestCheckResourceAttr("homeassistant_area.test", "area_id", "terraform_test_area"),
+					resource.TestCheckResourceAttr("homeassistant_area.test", "aliases.#", "1"),
+					resource.TestCheckResourceAttr("homeassistant_area.test", "icon", "mdi:flask"),
+				),
+			},
+			{
+				Config: testAccResourceAreaConfig_basic("mdi:test-tube"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("homeassistant_area.test", "icon", "mdi:test-tube"),
+				),
+			},
+			{
+				ResourceName:      "homeassistant_area.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceAreaConfig_basic(icon string) string {
+	return `
+resource "homeassistant_area" "test" {
+  name    = "Terraform Test Area"
+  aliases = ["Terraform Lab"]
+  icon    = "` + icon + `"
+}
+`
+}
@@ -0,0 +1,253 @@
+package homeassistant
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dawwestk/terraform-provider-homeassistant/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// automationDocuments maps the document attributes of an automation to the
+// configuration keys used since Home Assistant 2024.10, and the keys used
+// by automations created before then.
+var automationDocuments = []struct {
+	Attribute string
+	Key       string
+	LegacyKey string
+}{
+	{"triggers", "triggers", "trigger"},
+	{"conditions", "conditions", "condition"},
+	{"actions", "actions", "action"},
+}
+
+func resourceAutomation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAutomationCreate,
+		ReadContext:   resourceAutomationRead,
+		UpdateContext: resourceAutomationUpdate,
+		DeleteContext: resourceAutomationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"automation_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "Unique ID of the automation. Generated if not set.",
+			},
+			"alias": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the automation.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the automation.",
+			},
+			"mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "single",
+				ValidateFunc: validation.StringInSlice([]string{"single", "restart", "queued", "parallel"}, false),
+				Description:  "What happens when the automation is triggered while still running: single, restart, queued or parallel. Defaults to single.",
+			},
+			"max": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Maximum number of runs that can be queued or run in parallel. Only used with the queued and parallel modes.",
+			},
+			"triggers": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateDocument,
+				DiffSuppressFunc: suppressEquivalentDocument,
+				Description:      "Triggers of the automation, as a YAML or JSON list.",
+			},
+			"conditions": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateDocument,
+				DiffSuppressFunc: suppressEquivalentDocument,
+				Description:      "Conditions of the automation, as a YAML or JSON list.",
+			},
+			"actions": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateDocument,
+				DiffSuppressFunc: suppressEquivalentDocument,
+				Description:      "Actions of the automation, as a YAML or JSON list.",
+			},
+			// Computed attributes
+			"entity_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The entity ID of the automation (e.g., automation.porch_light).",
+			},
+		},
+	}
+}
+
+// automationConfigFromResourceData builds the automation configuration from the resource data.
+func automationConfigFromResourceData(d *schema.ResourceData, automationID string) (map[string]interface{}, error) {
+	config := map[string]interface{}{
+		"id":          automationID,
+		"alias":       d.Get("alias").(string),
+		"description": d.Get("description").(string),
+		"mode":        d.Get("mode").(string),
+	}
+
+	if max := d.Get("max").(int); max > 0 {
+		config["max"] = max
+	}
+
+	for _, doc := range automationDocuments {
+		value, err := parseDocument(d.Get(doc.Attribute).(string))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", doc.Attribute, err)
+		}
+		if value == nil {
+			value = []interface{}{}
+		}
+		config[doc.Key] = value
+	}
+
+	return config, nil
+}
+
+func resourceAutomationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	automationID := d.Get("automation_id").(string)
+	if automationID == "" {
+		automationID = id.UniqueId()
+	}
+
+	config, err := automationConfigFromResourceData(d, automationID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := c.SaveAutomationConfigContext(ctx, automationID, config); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to create automation: %w", err))
+	}
+
+	d.SetId(automationID)
+
+	if err := c.ReloadAutomationsContext(ctx); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceAutomationRead(ctx, d, m)
+}
+
+func resourceAutomationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	var diags diag.Diagnostics
+
+	config, err := c.GetAutomationConfigContext(ctx, d.Id())
+	if err != nil {
+		if client.IsNotFound(err) {
+			// The automation was deleted outside of Terraform
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(fmt.Errorf("failed to read automation: %w", err))
+	}
+
+	alias, _ := config["alias"].(string)
+	description, _ := config["description"].(string)
+	mode, _ := config["mode"].(string)
+	if mode == "" {
+		mode = "single"
+	}
+
+	d.Set("automation_id", d.Id())
+	d.Set("alias", alias)
+	d.Set("description", description)
+	d.Set("mode", mode)
+
+	if max, ok := config["max"].(float64); ok {
+		d.Set("max", int(max))
+	} else {
+		d.Set("max", 0)
+	}
+
+	for _, doc := range automationDocuments {
+		remote, ok := config[doc.Key]
+		if !ok {
+			remote = config[doc.LegacyKey]
+		}
+
+		value, err := documentValue(d.Get(doc.Attribute).(string), remote)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed to format %s: %w", doc.Attribute, err))
+		}
+		d.Set(doc.Attribute, value)
+	}
+
+	// Automation entities are registered with the automation ID as unique ID
+	entityID, err := c.FindEntityIDContext(ctx, "automation", d.Id())
+	if err != nil && !client.IsNotFound(err) {
+		return diag.FromErr(fmt.Errorf("failed to resolve automation entity ID: %w", err))
+	}
+	d.Set("entity_id", entityID)
+
+	return diags
+}
+
+func resourceAutomationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	config, err := automationConfigFromResourceData(d, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := c.SaveAutomationConfigContext(ctx, d.Id(), config); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to update automation: %w", err))
+	}
+
+	if err := c.ReloadAutomationsContext(ctx); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceAutomationRead(ctx, d, m)
+}
+
+func resourceAutomationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	var diags diag.Diagnostics
+
+	err := c.DeleteAutomationConfigContext(ctx, d.Id())
+	if err != nil && !client.IsNotFound(err) {
+		return diag.FromErr(fmt.Errorf("failed to delete automation: %w", err))
+	}
+
+	if err := c.ReloadAutomationsContext(ctx); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,204 @@
+package homeassistant
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceAutomation_Schema(t *testing.T) {
+	s := resourceAutomation().Schema
+
+	// Test required fields
+	requiredFields := []string{"alias", "triggers", "actions"}
+	for _, field := range requiredFields {
+		if !s[field].Required {
+			t.Errorf("expected %s to be required", field)
+		}
+	}
+
+	// Test optional fields
+	optionalFields := []string{"automation_id", "description", "mode", "max", "conditions"}
+	for _, field := range optionalFields {
+		if s[field].Required {
+			t.Errorf("expected %s to be optional", field)
+		}
+	}
+
+	// Test computed fields
+	computedFields := []string{"automation_id", "entity_id"}
+	for _, field := range computedFields {
+		if !s[field].Computed {
+			t.Errorf("expected %s to be computed", field)
+		}
+	}
+
+	if !s["automation_id"].ForceNew {
+		t.Error("expected automation_id to force a new resource")
+	}
+}
+
+func TestResourceAutomation_HasTimeouts(t *testing.T) {
+	r := resourceAutomation()
+	if r.Timeouts == nil {
+		t.Fatal("expected resource to have timeouts")
+	}
+	if r.Timeouts.Create == nil || r.Timeouts.Update == nil || r.Timeouts.Delete == nil {
+		t.Error("expected create, update and delete timeouts to be set")
+	}
+}
+
+func TestResourceAutomation_HasImporter(t *testing.T) {
+	r := resourceAutomation()
+	if r.Importer == nil {
+		t.Error("expected resource to have an importer")
+	}
+}
+
+func TestResourceAutomation_ModeValidation(t *testing.T) {
+	s := resourceAutomation().Schema["mode"]
+
+	validModes := []string{"single", "restart", "queued", "parallel"}
+	for _, mode := range validModes {
+		_, errs := s.ValidateFunc(mode, "mode")
+		if len(errs) > 0 {
+			t.Errorf("expected mode %s to be valid, got %v", mode, errs)
+		}
+	}
+
+	_, errs := s.ValidateFunc("sometimes", "mode")
+	if len(errs) == 0 {
+		t.Error("expected error for invalid mode")
+	}
+}
+
+func TestAutomationConfigFromResourceData(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceAutomation().Schema, map[string]interface{}{
+		"alias":    "Porch light",
+		"mode":     "queued",
+		"max":      5,
+		"triggers": "- trigger: sun\n  event: sunset\n",
+		"actions":  `[{"action": "light.turn_on", "target": {"entity_id": "light.porch"}}]`,
+	})
+
+	config, err := automationConfigFromResourceData(d, "porch_light")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if config["id"] != "porch_light" {
+		t.Errorf("expected id 'porch_light', got %v", config["id"])
+	}
+	if config["mode"] != "queued" || config["max"] != 5 {
+		t.Errorf("expected mode 'queued' with max 5, got %v and %v", config["mode"], config["max"])
+	}
+
+	expectedTriggers := []interface{}{
+		map[string]interface{}{"trigger": "sun", "event": "sunset"},
+	}
+	if !reflect.DeepEqual(config["triggers"], expectedTriggers) {
+		t.Errorf("expected triggers %v, got %v", expectedTriggers, config["triggers"])
+	}
+
+	// Unset conditions are sent as an empty list
+	if !reflect.DeepEqual(config["conditions"], []interface{}{}) {
+		t.Errorf("expected empty conditions, got %v", config["conditions"])
+	}
+
+	actions, ok := config["actions"].([]interface{})
+	if !ok || len(actions) != 1 {
+		t.Fatalf("expected 1 action, got %v", config["actions"])
+	}
+}
+
+func TestAutomationConfigFromResourceData_OmitsUnsetMax(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceAutomation().Schema, map[string]interface{}{
+		"alias":    "Porch light",
+		"triggers": "[]",
+		"actions":  "[]",
+	})
+
+	config, err := automationConfigFromResourceData(d, "porch_light")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if _, ok := config["max"]; ok {
+		t.Errorf("expected max to be omitted, got %v", config["max"])
+	}
+	if config["mode"] != "single" {
+		t.Errorf("expected default mode 'single', got %v", config["mode"])
+	}
+}
+
+// Acceptance tests - require a real Home Assistant instance
+
+func TestAccResourceAutomation_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceAutomationConfig_basic("Terraform test automation"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("homeassistant_automation.test", "alias", "Terraform test automation"),
+					resource.TestCheckResourceAttr("homeassistant_automation.test", "mode", "single"),
+					resource.TestCheckResourceAttrSet("homeassistant_automation.test", "automation_id"),
+					resource.TestCheckResourceAttrSet("homeassistant_automation.test", "entity_id"),
+				),
+			},
+			{
+				Config: testAccResourceAutomationConfig_basic("Terraform test automation renamed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("homeassistant_automation.test", "alias", "Terraform test automation renamed"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceAutomation_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceAutomationConfig_basic("Terraform test automation"),
+			},
+			{
+				ResourceName:      "homeassistant_automation.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Imported documents are formatted as YAML
+				ImportStateVerifyIgnore: []string{"triggers", "conditions", "actions"},
+			},
+		},
+	})
+}
+
+func testAccResourceAutomationConfig_basic(alias string) string {
+	return `
+resource "homeassistant_automation" "test" {
+  alias       = "` + alias + `"
+  description = "Managed by Terraform acceptance tests"
+
+  triggers = jsonencode([{
+    trigger = "sun"
+    event   = "sunset"
+  }])
+
+  conditions = <<-EOT
+    - condition: state
+      entity_id: sun.sun
+      state: below_horizon
+  EOT
+
+  actions = jsonencode([{
+    action = "persistent_notification.create"
+    data   = { message = "Sunset" }
+  }])
+}
+`
+}
@@ -0,0 +1,115 @@
+package homeassistant
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCounter() *schema.Resource {
+	return helperCollection{
+		Domain:      "counter",
+		Noun:        "counter",
+		IconExample: "mdi:counter",
+		Schema: map[string]*schema.Schema{
+			"initial": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Value of the counter when it is created or reset. Defaults to 0.",
+			},
+			"minimum": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Lowest value the counter can reach. Unbounded if unset.",
+			},
+			"maximum": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Highest value the counter can reach. Unbounded if unset.",
+			},
+			"step": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Amount the counter changes by on each increment or decrement. Defaults to 1.",
+			},
+			"restore": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the value from before a restart is restored instead of initial. Defaults to true.",
+			},
+		},
+		Expand:        expandCounter,
+		Flatten:       flattenCounter,
+		CustomizeDiff: resourceCounterCustomizeDiff,
+	}.resource()
+}
+
+// expandCounter adds the counter attributes to a storage collection payload.
+// Unset bounds are sent as null to remove them.
+func expandCounter(d *schema.ResourceData, payload map[string]interface{}) {
+	payload["initial"] = d.Get("initial").(int)
+	payload["step"] = d.Get("step").(int)
+	payload["restore"] = d.Get("restore").(bool)
+
+	for _, key := range []string{"minimum", "maximum"} {
+		payload[key] = nil
+		if isConfigured(d, key) {
+			payload[key] = d.Get(key).(int)
+		}
+	}
+}
+
+// flattenCounter sets the counter attributes from a stored item.
+func flattenCounter(d *schema.ResourceData, item map[string]interface{}) {
+	for _, key := range []string{"initial", "step", "minimum", "maximum"} {
+		if v, ok := itemInt(item, key); ok {
+			d.Set(key, v)
+		} else {
+			d.Set(key, nil)
+		}
+	}
+
+	restore, ok := item["restore"].(bool)
+	d.Set("restore", restore || !ok)
+}
+
+// checkCounterRange checks that minimum does not exceed maximum and that the
+// initial value lies within the bounds that are set.
+func checkCounterRange(initial int, minimum, maximum *int) error {
+	if minimum != nil && maximum != nil && *minimum > *maximum {
+		return fmt.Errorf("minimum (%d) must not be greater than maximum (%d)", *minimum, *maximum)
+	}
+	if minimum != nil && initial < *minimum {
+		return fmt.Errorf("initial (%d) must not be less than minimum (%d)", initial, *minimum)
+	}
+	if maximum != nil && initial > *maximum {
+		return fmt.Errorf("initial (%d) must not be greater than maximum (%d)", initial, *maximum)
+	}
+	return nil
+}
+
+// resourceCounterCustomizeDiff validates the bounds at plan time. Values that
+// are not known yet are checked on a later plan.
+func resourceCounterCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("initial") || !d.NewValueKnown("minimum") || !d.NewValueKnown("maximum") {
+		return nil
+	}
+
+	var minimum, maximum *int
+	if v, ok := configuredFloat(d, "minimum"); ok {
+		i := int(v)
+		minimum = &i
+	}
+	if v, ok := configuredFloat(d, "maximum"); ok {
+		i := int(v)
+		maximum = &i
+	}
+
+	return checkCounterRange(d.Get("initial").(int), minimum, maximum)
+}
@@ -0,0 +1,126 @@
+package homeassistant
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceCounter_Schema(t *testing.T) {
+	s := resourceCounter().Schema
+
+	// Test optional fields
+	optionalFields := []string{"initial", "minimum", "maximum", "step", "restore", "icon"}
+	for _, field := range optionalFields {
+		if !s[field].Optional {
+			t.Errorf("expected %s to be optional", field)
+		}
+	}
+
+	if _, errs := s["step"].ValidateFunc(0, "step"); len(errs) == 0 {
+		t.Error("expected step 0 to be invalid")
+	}
+}
+
+func TestExpandCounter(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceCounter().Schema, map[string]interface{}{
+		"name":    "Visitors",
+		"maximum": 10,
+	})
+
+	payload := map[string]interface{}{}
+	expandCounter(d, payload)
+
+	expected := map[string]interface{}{
+		"initial": 0,
+		"step":    1,
+		"restore": true,
+		"minimum": nil,
+		"maximum": 10,
+	}
+	if !reflect.DeepEqual(payload, expected) {
+		t.Errorf("expected payload %v, got %v", expected, payload)
+	}
+}
+
+func TestFlattenCounter(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceCounter().Schema, map[string]interface{}{})
+
+	flattenCounter(d, map[string]interface{}{
+		"initial": float64(5),
+		"step":    float64(2),
+		"minimum": float64(0),
+		"maximum": nil,
+		"restore": false,
+	})
+
+	if d.Get("initial") != 5 || d.Get("step") != 2 || d.Get("minimum") != 0 {
+		t.Errorf("unexpected values initial=%v step=%v minimum=%v", d.Get("initial"), d.Get("step"), d.Get("minimum"))
+	}
+	if d.Get("restore") != false {
+		t.Error("expected restore false")
+	}
+}
+
+func TestCheckCounterRange(t *testing.T) {
+	zero, ten := 0, 10
+
+	if err := checkCounterRange(5, nil, nil); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	if err := checkCounterRange(5, &zero, &ten); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	if err := checkCounterRange(5, &ten, &zero); err == nil {
+		t.Error("expected error for minimum greater than maximum")
+	}
+	if err := checkCounterRange(-1, &zero, nil); err == nil {
+		t.Error("expected error for initial below minimum")
+	}
+	if err := checkCounterRange(11, nil, &ten); err == nil {
+		t.Error("expected error for initial above maximum")
+	}
+}
+
+// Acceptance tests - require a real Home Assistant instance
+
+func TestAccResourceCounter_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceCounterConfig_basic(1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("homeassistant_counter.test", "step", "1"),
+					resource.TestCheckResourceAttrSet("homeassistant_counter.test", "entity_id"),
+				),
+			},
+			{
+				Config: testAccResourceCounterConfig_basic(2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("homeassistant_counter.test", "step", "2"),
+				),
+			},
+			{
+				ResourceName:      "homeassistant_counter.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceCounterConfig_basic(step int) string {
+	return fmt.Sprintf(`
+resource "homeassistant_counter" "test" {
+  name    = "Terraform Visitors"
+  minimum = 0
+  maximum = 100
+  step    = %d
+}
+`, step)
+}
@@ -0,0 +1,88 @@
+package homeassistant
+
+import (
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// isConfigured reports whether a top-level attribute is set in the
+// configuration, including to its zero value. Where the raw configuration is
+// not available, zero values are treated as unset.
+func isConfigured(d *schema.ResourceData, key string) bool {
+	if raw := d.GetRawConfig(); !raw.IsNull() {
+		return !raw.GetAttr(key).IsNull()
+	}
+
+	_, ok := d.GetOk(key)
+	return ok
+}
+
+// configuredFloat returns a number attribute from the configuration, and
+// whether it is set and known.
+func configuredFloat(d *schema.ResourceDiff, key string) (float64, bool) {
+	raw := d.GetRawConfig()
+	if raw.IsNull() {
+		return 0, false
+	}
+
+	v := raw.GetAttr(key)
+	if v.IsNull() || !v.IsKnown() {
+		return 0, false
+	}
+
+	f, _ := v.AsBigFloat().Float64()
+	return f, true
+}
+
+// configuredStrings returns a list of strings from the configuration, and
+// whether it is set and wholly known.
+func configuredStrings(d *schema.ResourceDiff, key string) ([]string, bool) {
+	raw := d.GetRawConfig()
+	if raw.IsNull() {
+		return nil, false
+	}
+
+	v := raw.GetAttr(key)
+	if v.IsNull() || !v.IsWhollyKnown() {
+		return nil, false
+	}
+
+	var values []string
+	for it := v.ElementIterator(); it.Next(); {
+		_, item := it.Element()
+		if item.IsNull() {
+			values = append(values, "")
+			continue
+		}
+		values = append(values, item.AsString())
+	}
+	return values, true
+}
+
+// configuredString returns a string attribute from the configuration, and
+// whether it is set and known.
+func configuredString(d *schema.ResourceDiff, key string) (string, bool) {
+	raw := d.GetRawConfig()
+	if raw.IsNull() {
+		return "", false
+	}
+
+	v := raw.GetAttr(key)
+	if v.IsNull() || !v.IsKnown() {
+		return "", false
+	}
+
+	return v.AsString(), true
+}
+
+// expandStringSet converts a set of strings from the resource data to a sorted slice.
+func expandStringSet(set *schema.Set) []string {
+	list := set.List()
+	values := make([]string, 0, len(list))
+	for _, v := range list {
+		values = append(values, v.(string))
+	}
+	sort.Strings(values)
+	return values
+}
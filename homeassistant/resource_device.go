@@ -0,0 +1,279 @@
+package homeassistant
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dawwestk/terraform-provider-homeassistant/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceDevice() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDeviceCreate,
+		ReadContext:   resourceDeviceRead,
+		UpdateContext: resourceDeviceUpdate,
+		DeleteContext: resourceDeviceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDeviceImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"device_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "ID of the device to customize, e.g. from the homeassistant_devices data source.",
+			},
+			"name_by_user": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Name of the device. Set to an empty string to use the name provided by the integration.",
+			},
+			"area_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the area of the device. Its entities follow it unless they have an area of their own.",
+			},
+			"labels": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Description: "IDs of the labels assigned to the device.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"disabled_by": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"", "user"}, false),
+				Description:  "What disabled the device, e.g. user or integration. Set to user to disable the device and its entities, or to an empty string to enable it.",
+			},
+			// Computed attributes
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the device provided by its integration.",
+			},
+			"manufacturer": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Manufacturer of the device.",
+			},
+			"model": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Model of the device.",
+			},
+			"original": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Settings of the device when it was adopted, restored on destroy.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name_by_user": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"area_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"labels": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"disabled_by": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// deviceChanges builds the registry update for the configured attributes.
+func deviceChanges(d *schema.ResourceData) map[string]interface{} {
+	changes := make(map[string]interface{})
+
+	for _, key := range []string{"name_by_user", "area_id"} {
+		if isConfigured(d, key) {
+			changes[key] = client.NullableString(d.Get(key).(string))
+		}
+	}
+	if isConfigured(d, "labels") {
+		changes["labels"] = expandStringSet(d.Get("labels").(*schema.Set))
+	}
+	if isConfigured(d, "disabled_by") {
+		changes["disabled_by"] = client.NullableString(d.Get("disabled_by").(string))
+	}
+
+	return changes
+}
+
+// flattenDeviceOriginal records the settings of a device so that they can be
+// restored on destroy.
+func flattenDeviceOriginal(device *client.DeviceRegistryEntry) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"name_by_user": device.NameByUser,
+			"area_id":      device.AreaID,
+			"labels":       device.Labels,
+			"disabled_by":  device.DisabledBy,
+		},
+	}
+}
+
+// deviceRestore builds the registry update that returns device to its
+// original settings. Settings that cannot be restored are reported as warnings.
+func deviceRestore(original map[string]interface{}, device *client.DeviceRegistryEntry) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	changes := make(map[string]interface{})
+
+	if name := original["name_by_user"].(string); name != device.NameByUser {
+		changes["name_by_user"] = client.NullableString(name)
+	}
+	if areaID := original["area_id"].(string); areaID != device.AreaID {
+		changes["area_id"] = client.NullableString(areaID)
+	}
+	if labels := interfaceStrings(original["labels"]); !sameStrings(labels, device.Labels) {
+		changes["labels"] = labels
+	}
+	diags = restoreFlag(diags, changes, "disabled_by", original["disabled_by"].(string), device.DisabledBy, "device "+device.ID)
+
+	return changes, diags
+}
+
+func resourceDeviceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	deviceID := d.Get("device_id").(string)
+
+	device, err := c.GetDeviceRegistryEntryContext(ctx, deviceID)
+	if err != nil {
+		if client.IsNotFound(err) {
+			return diag.Errorf("device %s is not in the device registry", deviceID)
+		}
+		return diag.FromErr(err)
+	}
+
+	d.SetId(device.ID)
+	d.Set("original", flattenDeviceOriginal(device))
+
+	if changes := deviceChanges(d); len(changes) > 0 {
+		if _, err := c.UpdateDeviceRegistryEntryContext(ctx, deviceID, changes); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceDeviceRead(ctx, d, m)
+}
+
+func resourceDeviceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	var diags diag.Diagnostics
+
+	device, err := c.GetDeviceRegistryEntryContext(ctx, d.Id())
+	if err != nil {
+		if client.IsNotFound(err) {
+			// The device was removed outside of Terraform
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(fmt.Errorf("failed to read device: %w", err))
+	}
+
+	d.Set("device_id", device.ID)
+	d.Set("name_by_user", device.NameByUser)
+	d.Set("area_id", device.AreaID)
+	d.Set("labels", device.Labels)
+	d.Set("disabled_by", device.DisabledBy)
+	d.Set("name", device.Name)
+	d.Set("manufacturer", device.Manufacturer)
+	d.Set("model", device.Model)
+
+	return diags
+}
+
+func resourceDeviceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	if changes := deviceChanges(d); len(changes) > 0 {
+		if _, err := c.UpdateDeviceRegistryEntryContext(ctx, d.Id(), changes); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceDeviceRead(ctx, d, m)
+}
+
+func resourceDeviceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	var diags diag.Diagnostics
+
+	device, err := c.GetDeviceRegistryEntryContext(ctx, d.Id())
+	if err != nil {
+		if client.IsNotFound(err) {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(fmt.Errorf("failed to read device: %w", err))
+	}
+
+	originals := d.Get("original").([]interface{})
+	if len(originals) == 0 || originals[0] == nil {
+		// Nothing was recorded to restore
+		d.SetId("")
+		return diags
+	}
+
+	changes, diags := deviceRestore(originals[0].(map[string]interface{}), device)
+	if len(changes) > 0 {
+		if _, err := c.UpdateDeviceRegistryEntryContext(ctx, device.ID, changes); err != nil {
+			return append(diags, diag.FromErr(fmt.Errorf("failed to restore device %s: %w", device.ID, err))...)
+		}
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// resourceDeviceImport adopts a device by its ID. The settings at the time of
+// import are restored on destroy.
+func resourceDeviceImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c := m.(*client.Client)
+
+	device, err := c.GetDeviceRegistryEntryContext(ctx, d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find device %s: %w", d.Id(), err)
+	}
+
+	d.Set("device_id", device.ID)
+	d.Set("original", flattenDeviceOriginal(device))
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,151 @@
+package homeassistant
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/dawwestk/terraform-provider-homeassistant/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceDevice_Schema(t *testing.T) {
+	s := resourceDevice().Schema
+
+	if !s["device_id"].Required || !s["device_id"].ForceNew {
+		t.Error("expected device_id to be required and force a new resource")
+	}
+
+	// Managed attributes keep the current value when not configured
+	managedFields := []string{"name_by_user", "area_id", "labels", "disabled_by"}
+	for _, field := range managedFields {
+		if !s[field].Optional || !s[field].Computed {
+			t.Errorf("expected %s to be optional and computed", field)
+		}
+	}
+
+	// Test computed fields
+	computedFields := []string{"name", "manufacturer", "model", "original"}
+	for _, field := range computedFields {
+		if !s[field].Computed {
+			t.Errorf("expected %s to be computed", field)
+		}
+	}
+}
+
+func TestDeviceChanges(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceDevice().Schema, map[string]interface{}{
+		"device_id":    "dev1",
+		"name_by_user": "Desk Lamp",
+		"labels":       []interface{}{"office", "evening"},
+		"disabled_by":  "user",
+	})
+
+	expected := map[string]interface{}{
+		"name_by_user": "Desk Lamp",
+		"labels":       []string{"evening", "office"},
+		"disabled_by":  "user",
+	}
+	if changes := deviceChanges(d); !reflect.DeepEqual(changes, expected) {
+		t.Errorf("expected changes %v, got %v", expected, changes)
+	}
+}
+
+func TestDeviceRestore(t *testing.T) {
+	device := &client.DeviceRegistryEntry{
+		ID:         "dev1",
+		NameByUser: "Desk Lamp",
+		AreaID:     "office",
+		Labels:     []string{"evening"},
+		DisabledBy: "user",
+	}
+
+	// The recorded values pass through the state as lists of interfaces
+	recorded := flattenDeviceOriginal(&client.DeviceRegistryEntry{
+		ID:     "dev1",
+		AreaID: "office",
+	})[0].(map[string]interface{})
+	recorded["labels"] = []interface{}{}
+
+	expected := map[string]interface{}{
+		"name_by_user": nil,
+		"labels":       []string{},
+		"disabled_by":  nil,
+	}
+	changes, diags := deviceRestore(recorded, device)
+	if len(diags) > 0 {
+		t.Errorf("expected no diagnostics, got %v", diags)
+	}
+	if !reflect.DeepEqual(changes, expected) {
+		t.Errorf("expected changes %v, got %v", expected, changes)
+	}
+}
+
+func TestDeviceRestore_DisabledByIntegration(t *testing.T) {
+	recorded := flattenDeviceOriginal(&client.DeviceRegistryEntry{
+		ID:         "dev1",
+		DisabledBy: "integration",
+	})[0].(map[string]interface{})
+	recorded["labels"] = []interface{}{}
+
+	if recorded["disabled_by"] != "integration" {
+		t.Fatalf("expected disabled_by integration to be recorded, got %v", recorded["disabled_by"])
+	}
+
+	// The registry does not accept disabled_by integration, so enabling the
+	// device cannot be undone and is only warned about
+	device := &client.DeviceRegistryEntry{ID: "dev1"}
+
+	changes, diags := deviceRestore(recorded, device)
+	if len(changes) != 0 {
+		t.Errorf("expected no changes, got %v", changes)
+	}
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Errorf("expected a warning about disabled_by, got %v", diags)
+	}
+
+	// A device still disabled by its integration needs no restore
+	device.DisabledBy = "integration"
+
+	changes, diags = deviceRestore(recorded, device)
+	if len(changes) != 0 || len(diags) != 0 {
+		t.Errorf("expected no changes or diagnostics, got %v %v", changes, diags)
+	}
+}
+
+// Acceptance tests - require a real Home Assistant instance
+
+func TestAccResourceDevice_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDeviceConfig_basic("Terraform Device"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("homeassistant_device.test", "name_by_user", "Terraform Device"),
+					resource.TestCheckResourceAttrSet("homeassistant_device.test", "name"),
+					resource.TestCheckResourceAttr("homeassistant_device.test", "original.#", "1"),
+				),
+			},
+			{
+				Config: testAccResourceDeviceConfig_basic("Terraform Device Renamed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("homeassistant_device.test", "name_by_user", "Terraform Device Renamed"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceDeviceConfig_basic(name string) string {
+	return `
+data "homeassistant_devices" "all" {}
+
+resource "homeassistant_device" "test" {
+  device_id    = data.homeassistant_devices.all.device_ids[0]
+  name_by_user = "` + name + `"
+}
+`
+}
@@ -0,0 +1,566 @@
+package homeassistant
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/dawwestk/terraform-provider-homeassistant/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// entityIDPattern matches entity IDs such as light.living_room.
+var entityIDPattern = regexp.MustCompile(`^[a-z0-9_]+\.[a-z0-9_]+$`)
+
+func resourceEntityRegistry() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceEntityRegistryCreate,
+		ReadContext:   resourceEntityRegistryRead,
+		UpdateContext: resourceEntityRegistryUpdate,
+		DeleteContext: resourceEntityRegistryDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceEntityRegistryImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"entity_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(entityIDPattern, "must be an entity ID such as light.living_room"),
+				Description:  "The entity to customize. It must have a unique ID to be in the entity registry.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Name of the entity. Set to an empty string to use the name provided by the integration.",
+			},
+			"icon": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "MDI icon for the entity. Set to an empty string to use the icon provided by the integration.",
+			},
+			"area_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the area of the entity. Set to an empty string to follow the area of its device.",
+			},
+			"labels": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Description: "IDs of the labels assigned to the entity.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"aliases": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Description: "Alternative names of the entity, used by voice assistants.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"hidden": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the entity is hidden from auto-generated dashboards and voice assistants.",
+			},
+			"disabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the entity is disabled. Disabling an entity may reload its integration.",
+			},
+			"new_entity_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(entityIDPattern, "must be an entity ID such as light.living_room"),
+				Description:  "New entity ID for the entity, in the same domain. The original ID is restored on destroy.",
+			},
+			"options": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Options of the entity for a domain, such as the unit of a sensor.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"domain": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+							Description:  "Domain the options apply to (e.g., sensor, conversation).",
+						},
+						"values": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     validateDocument,
+							DiffSuppressFunc: suppressEquivalentDocument,
+							Description:      "Options for the domain, as a YAML or JSON object. Replaces all options of the domain.",
+						},
+					},
+				},
+			},
+			// Computed attributes
+			"current_entity_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The entity ID after any rename through new_entity_id.",
+			},
+			"original_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the entity provided by its integration.",
+			},
+			"original": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Settings of the entity when it was adopted, restored on destroy.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"entity_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"icon": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"area_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"labels": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"aliases": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"hidden_by": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"disabled_by": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"options_json": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// userFlag converts a boolean to the hidden_by or disabled_by value that
+// marks an entity as hidden or disabled by the user.
+func userFlag(set bool) interface{} {
+	if set {
+		return "user"
+	}
+	return nil
+}
+
+// restoreFlag adds the hidden_by or disabled_by value that returns subject to
+// its original setting to changes. The registries only accept user or null, so
+// a flag originally set by an integration or config entry cannot be restored
+// and is reported as a warning instead.
+func restoreFlag(diags diag.Diagnostics, changes map[string]interface{}, key, original, current, subject string) diag.Diagnostics {
+	if original == current {
+		return diags
+	}
+
+	if original == "" || original == "user" {
+		changes[key] = client.NullableString(original)
+		return diags
+	}
+
+	return append(diags, diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("Could not restore %s of %s", key, subject),
+		Detail: fmt.Sprintf("%s was originally set to %q, which only Home Assistant can set, so it was left at %q.",
+			key, original, current),
+	})
+}
+
+// entityRegistryChanges builds the registry update for the configured
+// attributes of an entity currently registered as entry. Options are applied
+// separately, one domain at a time.
+func entityRegistryChanges(d *schema.ResourceData, entry *client.EntityRegistryEntry) (map[string]interface{}, error) {
+	changes := make(map[string]interface{})
+
+	for _, key := range []string{"name", "icon", "area_id"} {
+		if isConfigured(d, key) {
+			changes[key] = client.NullableString(d.Get(key).(string))
+		}
+	}
+	for _, key := range []string{"labels", "aliases"} {
+		if isConfigured(d, key) {
+			changes[key] = expandStringSet(d.Get(key).(*schema.Set))
+		}
+	}
+	if isConfigured(d, "hidden") {
+		changes["hidden_by"] = userFlag(d.Get("hidden").(bool))
+	}
+	if isConfigured(d, "disabled") {
+		changes["disabled_by"] = userFlag(d.Get("disabled").(bool))
+	}
+
+	desiredEntityID := d.Get("new_entity_id").(string)
+	if desiredEntityID == "" {
+		desiredEntityID = d.Get("entity_id").(string)
+	}
+	if desiredEntityID != entry.EntityID {
+		domain, _, _ := strings.Cut(entry.EntityID, ".")
+		if !strings.HasPrefix(desiredEntityID, domain+".") {
+			return nil, fmt.Errorf("new_entity_id %s must be in the %s domain", desiredEntityID, domain)
+		}
+		changes["new_entity_id"] = desiredEntityID
+	}
+
+	return changes, nil
+}
+
+// applyEntityRegistryChanges applies the configured attributes and options
+// to the entity currently registered as entry.
+func applyEntityRegistryChanges(ctx context.Context, c *client.Client, d *schema.ResourceData, entry *client.EntityRegistryEntry) error {
+	changes, err := entityRegistryChanges(d, entry)
+	if err != nil {
+		return err
+	}
+
+	entityID := entry.EntityID
+	if len(changes) > 0 {
+		updated, err := c.UpdateEntityRegistryEntryContext(ctx, entityID, changes)
+		if err != nil {
+			return err
+		}
+		entityID = updated.EntityID
+	}
+
+	for _, raw := range d.Get("options").([]interface{}) {
+		opts := raw.(map[string]interface{})
+		domain := opts["domain"].(string)
+
+		values, err := parseDocument(opts["values"].(string))
+		if err != nil {
+			return fmt.Errorf("failed to parse %s options: %w", domain, err)
+		}
+		if values == nil {
+			values = map[string]interface{}{}
+		}
+
+		_, err = c.UpdateEntityRegistryEntryContext(ctx, entityID, map[string]interface{}{
+			"options_domain": domain,
+			"options":        values,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// flattenEntityRegistryOriginal records the settings of an entry so that
+// they can be restored on destroy.
+func flattenEntityRegistryOriginal(entry *client.EntityRegistryEntry) ([]interface{}, error) {
+	options, err := json.Marshal(entry.Options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode options of %s: %w", entry.EntityID, err)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"entity_id":    entry.EntityID,
+			"name":         entry.Name,
+			"icon":         entry.Icon,
+			"area_id":      entry.AreaID,
+			"labels":       entry.Labels,
+			"aliases":      entry.Aliases,
+			"hidden_by":    entry.HiddenBy,
+			"disabled_by":  entry.DisabledBy,
+			"options_json": string(options),
+		},
+	}, nil
+}
+
+// entityRegistryRestore builds the registry updates that return the entity
+// currently registered as entry to its original settings. The first update
+// holds the settings and the others the options of each managed domain.
+// Settings that cannot be restored are reported as warnings.
+func entityRegistryRestore(original map[string]interface{}, managedDomains []string, entry *client.EntityRegistryEntry) ([]map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	changes := make(map[string]interface{})
+
+	if name := original["name"].(string); name != entry.Name {
+		changes["name"] = client.NullableString(name)
+	}
+	if icon := original["icon"].(string); icon != entry.Icon {
+		changes["icon"] = client.NullableString(icon)
+	}
+	if areaID := original["area_id"].(string); areaID != entry.AreaID {
+		changes["area_id"] = client.NullableString(areaID)
+	}
+	if labels := interfaceStrings(original["labels"]); !sameStrings(labels, entry.Labels) {
+		changes["labels"] = labels
+	}
+	if aliases := interfaceStrings(original["aliases"]); !sameStrings(aliases, entry.Aliases) {
+		changes["aliases"] = aliases
+	}
+	diags = restoreFlag(diags, changes, "hidden_by", original["hidden_by"].(string), entry.HiddenBy, entry.EntityID)
+	diags = restoreFlag(diags, changes, "disabled_by", original["disabled_by"].(string), entry.DisabledBy, entry.EntityID)
+	if entityID := original["entity_id"].(string); entityID != "" && entityID != entry.EntityID {
+		changes["new_entity_id"] = entityID
+	}
+
+	updates := []map[string]interface{}{changes}
+
+	var options map[string]map[string]interface{}
+	if raw := original["options_json"].(string); raw != "" {
+		if err := json.Unmarshal([]byte(raw), &options); err != nil {
+			return nil, append(diags, diag.FromErr(fmt.Errorf("failed to decode original options: %w", err))...)
+		}
+	}
+
+	for _, domain := range managedDomains {
+		values := options[domain]
+		if values == nil {
+			values = map[string]interface{}{}
+		}
+		current := entry.Options[domain]
+		if current == nil {
+			current = map[string]interface{}{}
+		}
+		if !reflect.DeepEqual(values, current) {
+			updates = append(updates, map[string]interface{}{
+				"options_domain": domain,
+				"options":        values,
+			})
+		}
+	}
+
+	return updates, diags
+}
+
+func resourceEntityRegistryCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	entityID := d.Get("entity_id").(string)
+
+	entry, err := c.GetEntityRegistryEntryContext(ctx, entityID)
+	if err != nil {
+		if client.IsNotFound(err) {
+			return diag.Errorf("%s is not in the entity registry: only entities with a unique ID can be customized", entityID)
+		}
+		return diag.FromErr(err)
+	}
+
+	original, err := flattenEntityRegistryOriginal(entry)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(entry.ID)
+	d.Set("original", original)
+
+	if err := applyEntityRegistryChanges(ctx, c, d, entry); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to customize %s: %w", entityID, err))
+	}
+
+	return resourceEntityRegistryRead(ctx, d, m)
+}
+
+func resourceEntityRegistryRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	var diags diag.Diagnostics
+
+	entry, err := c.GetEntityRegistryEntryByIDContext(ctx, d.Id())
+	if err != nil {
+		if client.IsNotFound(err) {
+			// The entity was removed from the registry outside of Terraform
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(fmt.Errorf("failed to read entity registry entry: %w", err))
+	}
+
+	d.Set("current_entity_id", entry.EntityID)
+	d.Set("original_name", entry.OriginalName)
+	d.Set("name", entry.Name)
+	d.Set("icon", entry.Icon)
+	d.Set("area_id", entry.AreaID)
+	d.Set("labels", entry.Labels)
+	d.Set("aliases", entry.Aliases)
+	d.Set("hidden", entry.HiddenBy != "")
+	d.Set("disabled", entry.DisabledBy != "")
+
+	if d.Get("new_entity_id").(string) != "" {
+		d.Set("new_entity_id", entry.EntityID)
+	}
+
+	// Only the options of configured domains are managed
+	options := d.Get("options").([]interface{})
+	for i, raw := range options {
+		opts := raw.(map[string]interface{})
+		values, err := documentValue(opts["values"].(string), entry.Options[opts["domain"].(string)])
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed to format %s options: %w", opts["domain"], err))
+		}
+		opts["values"] = values
+		options[i] = opts
+	}
+	d.Set("options", options)
+
+	return diags
+}
+
+func resourceEntityRegistryUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	entry, err := c.GetEntityRegistryEntryByIDContext(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read entity registry entry: %w", err))
+	}
+
+	if err := applyEntityRegistryChanges(ctx, c, d, entry); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to customize %s: %w", entry.EntityID, err))
+	}
+
+	return resourceEntityRegistryRead(ctx, d, m)
+}
+
+func resourceEntityRegistryDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	var diags diag.Diagnostics
+
+	entry, err := c.GetEntityRegistryEntryByIDContext(ctx, d.Id())
+	if err != nil {
+		if client.IsNotFound(err) {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(fmt.Errorf("failed to read entity registry entry: %w", err))
+	}
+
+	originals := d.Get("original").([]interface{})
+	if len(originals) == 0 || originals[0] == nil {
+		// Nothing was recorded to restore
+		d.SetId("")
+		return diags
+	}
+
+	var managedDomains []string
+	for _, raw := range d.Get("options").([]interface{}) {
+		domain := raw.(map[string]interface{})["domain"].(string)
+		if !slices.Contains(managedDomains, domain) {
+			managedDomains = append(managedDomains, domain)
+		}
+	}
+
+	updates, restoreDiags := entityRegistryRestore(originals[0].(map[string]interface{}), managedDomains, entry)
+	diags = append(diags, restoreDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	entityID := entry.EntityID
+	for _, changes := range updates {
+		if len(changes) == 0 {
+			continue
+		}
+		updated, err := c.UpdateEntityRegistryEntryContext(ctx, entityID, changes)
+		if err != nil {
+			return append(diags, diag.FromErr(fmt.Errorf("failed to restore %s: %w", entityID, err))...)
+		}
+		entityID = updated.EntityID
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// resourceEntityRegistryImport adopts an entity by its entity ID. The
+// settings at the time of import are restored on destroy.
+func resourceEntityRegistryImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c := m.(*client.Client)
+
+	entityID := d.Id()
+
+	entry, err := c.GetEntityRegistryEntryContext(ctx, entityID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find %s in the entity registry: %w", entityID, err)
+	}
+
+	original, err := flattenEntityRegistryOriginal(entry)
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(entry.ID)
+	d.Set("entity_id", entry.EntityID)
+	d.Set("original", original)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// interfaceStrings converts a list read from the resource data to strings.
+func interfaceStrings(v interface{}) []string {
+	list, _ := v.([]interface{})
+	values := make([]string, 0, len(list))
+	for _, item := range list {
+		s, _ := item.(string)
+		values = append(values, s)
+	}
+	return values
+}
+
+// sameStrings reports whether a and b hold the same strings in any order.
+func sameStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(a, b)
+}
@@ -0,0 +1,284 @@
+package homeassistant
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/dawwestk/terraform-provider-homeassistant/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceEntityRegistry_Schema(t *testing.T) {
+	s := resourceEntityRegistry().Schema
+
+	if !s["entity_id"].Required || !s["entity_id"].ForceNew {
+		t.Error("expected entity_id to be required and force a new resource")
+	}
+
+	// Managed attributes keep the current value when not configured
+	managedFields := []string{"name", "icon", "area_id", "labels", "aliases", "hidden", "disabled"}
+	for _, field := range managedFields {
+		if !s[field].Optional || !s[field].Computed {
+			t.Errorf("expected %s to be optional and computed", field)
+		}
+	}
+
+	// Test computed fields
+	computedFields := []string{"current_entity_id", "original_name", "original"}
+	for _, field := range computedFields {
+		if !s[field].Computed {
+			t.Errorf("expected %s to be computed", field)
+		}
+	}
+}
+
+func TestResourceEntityRegistry_EntityIDValidation(t *testing.T) {
+	s := resourceEntityRegistry().Schema["new_entity_id"]
+
+	if _, errs := s.ValidateFunc("light.hallway_ceiling", "new_entity_id"); len(errs) > 0 {
+		t.Errorf("expected valid entity ID, got %v", errs)
+	}
+
+	invalidIDs := []string{"hallway", "light.Hallway", "light.hallway.ceiling", "light."}
+	for _, id := range invalidIDs {
+		if _, errs := s.ValidateFunc(id, "new_entity_id"); len(errs) == 0 {
+			t.Errorf("expected %q to be invalid", id)
+		}
+	}
+}
+
+func TestEntityRegistryChanges(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceEntityRegistry().Schema, map[string]interface{}{
+		"entity_id":     "light.hallway",
+		"name":          "Corridor",
+		"labels":        []interface{}{"evening"},
+		"hidden":        true,
+		"new_entity_id": "light.corridor",
+	})
+
+	changes, err := entityRegistryChanges(d, &client.EntityRegistryEntry{EntityID: "light.hallway"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	expected := map[string]interface{}{
+		"name":          "Corridor",
+		"labels":        []string{"evening"},
+		"hidden_by":     "user",
+		"new_entity_id": "light.corridor",
+	}
+	if !reflect.DeepEqual(changes, expected) {
+		t.Errorf("expected changes %v, got %v", expected, changes)
+	}
+}
+
+func TestEntityRegistryChanges_AlreadyRenamed(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceEntityRegistry().Schema, map[string]interface{}{
+		"entity_id":     "light.hallway",
+		"new_entity_id": "light.corridor",
+	})
+
+	changes, err := entityRegistryChanges(d, &client.EntityRegistryEntry{EntityID: "light.corridor"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(changes) != 0 {
+		t.Errorf("expected no changes, got %v", changes)
+	}
+}
+
+func TestEntityRegistryChanges_RenameAcrossDomains(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceEntityRegistry().Schema, map[string]interface{}{
+		"entity_id":     "light.hallway",
+		"new_entity_id": "switch.hallway",
+	})
+
+	if _, err := entityRegistryChanges(d, &client.EntityRegistryEntry{EntityID: "light.hallway"}); err == nil {
+		t.Error("expected error when renaming to another domain")
+	}
+}
+
+func TestEntityRegistryRestore(t *testing.T) {
+	entry := &client.EntityRegistryEntry{
+		ID:       "abc123",
+		EntityID: "sensor.corridor_temperature",
+		Name:     "Corridor",
+		Labels:   []string{"heating"},
+		Aliases:  []string{},
+		HiddenBy: "user",
+		Options: map[string]map[string]interface{}{
+			"sensor": {"display_precision": float64(1)},
+		},
+	}
+
+	original, err := flattenEntityRegistryOriginal(&client.EntityRegistryEntry{
+		EntityID:   "sensor.hallway_temperature",
+		Icon:       "mdi:thermometer",
+		Labels:     []string{"heating"},
+		DisabledBy: "integration",
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	// The recorded values pass through the state as lists of interfaces
+	recorded := original[0].(map[string]interface{})
+	recorded["labels"] = []interface{}{"heating"}
+	recorded["aliases"] = []interface{}{}
+
+	updates, diags := entityRegistryRestore(recorded, []string{"sensor"}, entry)
+	if diags.HasError() {
+		t.Fatalf("expected no error, got %v", diags)
+	}
+
+	// The registry does not accept disabled_by integration, so it is only warned about
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Errorf("expected a warning about disabled_by, got %v", diags)
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"name":          nil,
+			"icon":          "mdi:thermometer",
+			"hidden_by":     nil,
+			"new_entity_id": "sensor.hallway_temperature",
+		},
+		{
+			"options_domain": "sensor",
+			"options":        map[string]interface{}{},
+		},
+	}
+	if !reflect.DeepEqual(updates, expected) {
+		t.Errorf("expected updates %v, got %v", expected, updates)
+	}
+}
+
+func TestEntityRegistryRestore_HiddenByIntegration(t *testing.T) {
+	original, err := flattenEntityRegistryOriginal(&client.EntityRegistryEntry{
+		EntityID: "sensor.printer_uptime",
+		HiddenBy: "integration",
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	recorded := original[0].(map[string]interface{})
+	if recorded["hidden_by"] != "integration" {
+		t.Fatalf("expected hidden_by integration to be recorded, got %v", recorded["hidden_by"])
+	}
+	recorded["labels"] = []interface{}{}
+	recorded["aliases"] = []interface{}{}
+
+	// hidden_by integration cannot be sent to the registry, so it is never
+	// part of the restore and changes to it are warned about
+	tests := []struct {
+		name     string
+		hiddenBy string
+		warns    bool
+	}{
+		{"still hidden", "integration", false},
+		{"shown", "", true},
+		{"hidden by user", "user", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry := &client.EntityRegistryEntry{
+				EntityID: "sensor.printer_uptime",
+				HiddenBy: tt.hiddenBy,
+			}
+
+			updates, diags := entityRegistryRestore(recorded, nil, entry)
+			if diags.HasError() {
+				t.Fatalf("expected no error, got %v", diags)
+			}
+			if expected := []map[string]interface{}{{}}; !reflect.DeepEqual(updates, expected) {
+				t.Errorf("expected updates %v, got %v", expected, updates)
+			}
+			if warns := len(diags) == 1 && diags[0].Severity == diag.Warning; warns != tt.warns {
+				t.Errorf("expected warning %t, got %v", tt.warns, diags)
+			}
+		})
+	}
+}
+
+func TestRestoreFlag(t *testing.T) {
+	tests := []struct {
+		name     string
+		original string
+		current  string
+		expected map[string]interface{}
+		warns    bool
+	}{
+		{"unchanged", "integration", "integration", map[string]interface{}{}, false},
+		{"clear", "", "user", map[string]interface{}{"disabled_by": nil}, false},
+		{"set by user", "user", "", map[string]interface{}{"disabled_by": "user"}, false},
+		{"set by integration", "integration", "", map[string]interface{}{}, true},
+		{"set by config entry", "config_entry", "user", map[string]interface{}{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes := map[string]interface{}{}
+			diags := restoreFlag(nil, changes, "disabled_by", tt.original, tt.current, "light.desk")
+
+			if !reflect.DeepEqual(changes, tt.expected) {
+				t.Errorf("expected changes %v, got %v", tt.expected, changes)
+			}
+			if warns := len(diags) == 1 && diags[0].Severity == diag.Warning; warns != tt.warns {
+				t.Errorf("expected warning %t, got %v", tt.warns, diags)
+			}
+		})
+	}
+}
+
+func TestSameStrings(t *testing.T) {
+	if !sameStrings([]string{"a", "b"}, []string{"b", "a"}) {
+		t.Error("expected lists in different order to be the same")
+	}
+	if !sameStrings(nil, []string{}) {
+		t.Error("expected nil and empty lists to be the same")
+	}
+	if sameStrings([]string{"a"}, []string{"a", "b"}) {
+		t.Error("expected lists of different length to differ")
+	}
+}
+
+// Acceptance tests - require a real Home Assistant instance
+
+func TestAccResourceEntityRegistry_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceEntityRegistryConfig_basic("Terraform Sun"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("homeassistant_entity_registry.test", "name", "Terraform Sun"),
+					resource.TestCheckResourceAttr("homeassistant_entity_registry.test", "icon", "mdi:weather-sunny"),
+					resource.TestCheckResourceAttr("homeassistant_entity_registry.test", "current_entity_id", "sun.sun"),
+					resource.TestCheckResourceAttr("homeassistant_entity_registry.test", "original.0.entity_id", "sun.sun"),
+				),
+			},
+			{
+				Config: testAccResourceEntityRegistryConfig_basic("Terraform Daylight"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("homeassistant_entity_registry.test", "name", "Terraform Daylight"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceEntityRegistryConfig_basic(name string) string {
+	return `
+resource "homeassistant_entity_registry" "test" {
+  entity_id = "sun.sun"
+  name      = "` + name + `"
+  icon      = "mdi:weather-sunny"
+  aliases   = ["Daystar"]
+}
+`
+}
@@ -0,0 +1,152 @@
+package homeassistant
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dawwestk/terraform-provider-homeassistant/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceFloor() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceFloorCreate,
+		ReadContext:   resourceFloorRead,
+		UpdateContext: resourceFloorUpdate,
+		DeleteContext: resourceFloorDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "Name of the floor.",
+			},
+			"level": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Level of the floor, used to order floors (e.g., 0 for the ground floor, -1 for a basement).",
+			},
+			"icon": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "MDI icon for the floor (e.g., mdi:home-floor-1).",
+			},
+			"aliases": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Alternative names of the floor, used by voice assistants.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			// Computed attributes
+			"floor_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the floor, derived from its name at creation (e.g., ground_floor).",
+			},
+		},
+	}
+}
+
+// floorFromResourceData builds a floor registry entry from the resource data.
+func floorFromResourceData(d *schema.ResourceData) client.Floor {
+	return client.Floor{
+		Name:    d.Get("name").(string),
+		Level:   floorLevel(d),
+		Icon:    d.Get("icon").(string),
+		Aliases: expandStringSet(d.Get("aliases").(*schema.Set)),
+	}
+}
+
+// floorLevel returns the configured level, or nil if none is set.
+// Level 0 is a valid level, so it is not treated as unset.
+func floorLevel(d *schema.ResourceData) *int {
+	if !isConfigured(d, "level") {
+		return nil
+	}
+
+	level := d.Get("level").(int)
+	return &level
+}
+
+func resourceFloorCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	floor, err := c.CreateFloorContext(ctx, floorFromResourceData(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(floor.FloorID)
+
+	return resourceFloorRead(ctx, d, m)
+}
+
+func resourceFloorRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	var diags diag.Diagnostics
+
+	floor, err := c.GetFloorContext(ctx, d.Id())
+	if err != nil {
+		if client.IsNotFound(err) {
+			// The floor was deleted outside of Terraform
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(fmt.Errorf("failed to read floor: %w", err))
+	}
+
+	d.Set("floor_id", floor.FloorID)
+	d.Set("name", floor.Name)
+	d.Set("icon", floor.Icon)
+	d.Set("aliases", floor.Aliases)
+	if floor.Level != nil {
+		d.Set("level", *floor.Level)
+	} else {
+		d.Set("level", nil)
+	}
+
+	return diags
+}
+
+func resourceFloorUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	_, err := c.UpdateFloorContext(ctx, d.Id(), floorFromResourceData(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceFloorRead(ctx, d, m)
+}
+
+func resourceFloorDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	var diags diag.Diagnostics
+
+	err := c.DeleteFloorContext(ctx, d.Id())
+	if err != nil && !client.IsNotFound(err) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,105 @@
+package homeassistant
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceFloor_Schema(t *testing.T) {
+	s := resourceFloor().Schema
+
+	if !s["name"].Required {
+		t.Error("expected name to be required")
+	}
+
+	// Test optional fields
+	optionalFields := []string{"level", "icon", "aliases"}
+	for _, field := range optionalFields {
+		if !s[field].Optional {
+			t.Errorf("expected %s to be optional", field)
+		}
+	}
+
+	if !s["floor_id"].Computed {
+		t.Error("expected floor_id to be computed")
+	}
+}
+
+func TestResourceFloor_HasImporter(t *testing.T) {
+	r := resourceFloor()
+	if r.Importer == nil {
+		t.Error("expected resource to have an importer")
+	}
+}
+
+func TestFloorFromResourceData(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceFloor().Schema, map[string]interface{}{
+		"name":    "Basement",
+		"level":   -1,
+		"aliases": []interface{}{"cellar"},
+	})
+
+	floor := floorFromResourceData(d)
+
+	if floor.Name != "Basement" {
+		t.Errorf("expected name 'Basement', got %s", floor.Name)
+	}
+	if floor.Level == nil || *floor.Level != -1 {
+		t.Errorf("expected level -1, got %v", floor.Level)
+	}
+	if len(floor.Aliases) != 1 || floor.Aliases[0] != "cellar" {
+		t.Errorf("expected aliases [cellar], got %v", floor.Aliases)
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceFloor().Schema, map[string]interface{}{
+		"name": "Attic",
+	})
+
+	if level := floorFromResourceData(d).Level; level != nil {
+		t.Errorf("expected no level, got %d", *level)
+	}
+}
+
+// Acceptance tests - require a real Home Assistant instance
+
+func TestAccResourceFloor_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceFloorConfig_basic(0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("homeassistant_floor.test", "name", "Terraform Test Floor"),
+					resource.TestCheckResourceAttr("homeassistant_floor.test", "floor_id", "terraform_test_floor"),
+					resource.TestCheckResourceAttr("homeassistant_floor.test", "level", "0"),
+				),
+			},
+			{
+				Config: testAccResourceFloorConfig_basic(2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("homeassistant_floor.test", "level", "2"),
+				),
+			},
+			{
+				ResourceName:      "homeassistant_floor.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceFloorConfig_basic(level int) string {
+	return fmt.Sprintf(`
+resource "homeassistant_floor" "test" {
+  name    = "Terraform Test Floor"
+  level   = %d
+  icon    = "mdi:home-floor-0"
+  aliases = ["Terraform Level"]
+}
+`, level)
+}
@@ -0,0 +1,78 @@
+package homeassistant
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dawwestk/terraform-provider-homeassistant/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceInputBoolean() *schema.Resource {
+	return helperCollection{
+		Domain:      "input_boolean",
+		Noun:        "input boolean",
+		IconExample: "mdi:account-group",
+		Schema: map[string]*schema.Schema{
+			"initial": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "State of the input boolean when Home Assistant starts. If never set, the state from before the restart is restored.",
+			},
+			"state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"on", "off"}, false),
+				Description:  "Desired state of the input boolean: 'on' or 'off'. If not specified, the state is left to automations and only read.",
+			},
+		},
+		Expand:       expandInputBoolean,
+		Flatten:      flattenInputBoolean,
+		AfterWrite:   applyInputBooleanState,
+		FlattenState: flattenInputBooleanState,
+	}.resource()
+}
+
+// expandInputBoolean adds the input boolean attributes to a storage collection payload.
+func expandInputBoolean(d *schema.ResourceData, payload map[string]interface{}) {
+	if isConfigured(d, "initial") {
+		payload["initial"] = d.Get("initial").(bool)
+	}
+}
+
+// flattenInputBoolean sets the input boolean attributes from a stored item.
+func flattenInputBoolean(d *schema.ResourceData, item map[string]interface{}) {
+	if initial, ok := item["initial"].(bool); ok {
+		d.Set("initial", initial)
+	}
+}
+
+// applyInputBooleanState calls input_boolean.turn_on or input_boolean.turn_off
+// for the entity when the desired state is set on create or changed.
+func applyInputBooleanState(ctx context.Context, c *client.Client, d *schema.ResourceData) error {
+	if !isConfigured(d, "state") || (!d.IsNewResource() && !d.HasChange("state")) {
+		return nil
+	}
+
+	service := "turn_off"
+	if d.Get("state").(string) == "on" {
+		service = "turn_on"
+	}
+
+	// Turning an input boolean on or off sets an absolute state, so it is safe to retry
+	_, err := c.CallServiceContext(client.WithRetrySafe(ctx), "input_boolean", service, map[string]interface{}{
+		"entity_id": d.Get("entity_id").(string),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set input boolean state: %w", err)
+	}
+	return nil
+}
+
+// flattenInputBooleanState sets the current state of the input boolean.
+func flattenInputBooleanState(d *schema.ResourceData, state *client.State) {
+	d.Set("state", state.State)
+}
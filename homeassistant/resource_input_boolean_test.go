@@ -0,0 +1,116 @@
+package homeassistant
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceInputBoolean_Schema(t *testing.T) {
+	s := resourceInputBoolean().Schema
+
+	if !s["name"].Required {
+		t.Error("expected name to be required")
+	}
+
+	// Test optional fields
+	optionalFields := []string{"icon", "initial", "state"}
+	for _, field := range optionalFields {
+		if !s[field].Optional || !s[field].Computed {
+			t.Errorf("expected %s to be optional and computed", field)
+		}
+	}
+
+	if !s["entity_id"].Computed {
+		t.Error("expected entity_id to be computed")
+	}
+}
+
+func TestResourceInputBoolean_HasImporter(t *testing.T) {
+	r := resourceInputBoolean()
+	if r.Importer == nil {
+		t.Error("expected resource to have an importer")
+	}
+}
+
+func TestResourceInputBoolean_StateValidation(t *testing.T) {
+	s := resourceInputBoolean().Schema["state"]
+
+	for _, v := range []string{"on", "off"} {
+		if _, errs := s.ValidateFunc(v, "state"); len(errs) > 0 {
+			t.Errorf("expected %q to be valid, got %v", v, errs)
+		}
+	}
+	if _, errs := s.ValidateFunc("unavailable", "state"); len(errs) == 0 {
+		t.Error("expected 'unavailable' to be invalid")
+	}
+}
+
+func TestExpandInputBoolean(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceInputBoolean().Schema, map[string]interface{}{
+		"name":    "Guest mode",
+		"icon":    "mdi:account-group",
+		"initial": true,
+	})
+
+	payload := map[string]interface{}{}
+	expandInputBoolean(d, payload)
+
+	expected := map[string]interface{}{"initial": true}
+	if !reflect.DeepEqual(payload, expected) {
+		t.Errorf("expected payload %v, got %v", expected, payload)
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceInputBoolean().Schema, map[string]interface{}{
+		"name": "Guest mode",
+	})
+
+	payload = map[string]interface{}{}
+	expandInputBoolean(d, payload)
+
+	if _, ok := payload["initial"]; ok {
+		t.Errorf("expected no initial, got %v", payload["initial"])
+	}
+}
+
+// Acceptance tests - require a real Home Assistant instance
+
+func TestAccResourceInputBoolean_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceInputBooleanConfig_basic("on"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("homeassistant_input_boolean.test", "name", "Terraform Guest Mode"),
+					resource.TestCheckResourceAttr("homeassistant_input_boolean.test", "state", "on"),
+					resource.TestCheckResourceAttrSet("homeassistant_input_boolean.test", "entity_id"),
+				),
+			},
+			{
+				Config: testAccResourceInputBooleanConfig_basic("off"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("homeassistant_input_boolean.test", "state", "off"),
+				),
+			},
+			{
+				ResourceName:      "homeassistant_input_boolean.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceInputBooleanConfig_basic(state string) string {
+	return `
+resource "homeassistant_input_boolean" "test" {
+  name  = "Terraform Guest Mode"
+  icon  = "mdi:account-group"
+  state = "` + state + `"
+}
+`
+}
@@ -0,0 +1,13 @@
+package homeassistant
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceInputButton() *schema.Resource {
+	return helperCollection{
+		Domain:      "input_button",
+		Noun:        "input button",
+		IconExample: "mdi:gesture-tap-button",
+	}.resource()
+}
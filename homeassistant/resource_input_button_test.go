@@ -0,0 +1,59 @@
+package homeassistant
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestResourceInputButton_Schema(t *testing.T) {
+	s := resourceInputButton().Schema
+
+	if !s["name"].Required {
+		t.Error("expected name to be required")
+	}
+	if !s["icon"].Optional {
+		t.Error("expected icon to be optional")
+	}
+	if !s["entity_id"].Computed {
+		t.Error("expected entity_id to be computed")
+	}
+}
+
+// Acceptance tests - require a real Home Assistant instance
+
+func TestAccResourceInputButton_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceInputButtonConfig_basic("mdi:bell"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("homeassistant_input_button.test", "icon", "mdi:bell"),
+					resource.TestCheckResourceAttrSet("homeassistant_input_button.test", "entity_id"),
+				),
+			},
+			{
+				Config: testAccResourceInputButtonConfig_basic("mdi:doorbell"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("homeassistant_input_button.test", "icon", "mdi:doorbell"),
+				),
+			},
+			{
+				ResourceName:      "homeassistant_input_button.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceInputButtonConfig_basic(icon string) string {
+	return `
+resource "homeassistant_input_button" "test" {
+  name = "Terraform Doorbell"
+  icon = "` + icon + `"
+}
+`
+}
@@ -0,0 +1,102 @@
+package homeassistant
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceInputDatetime() *schema.Resource {
+	return helperCollection{
+		Domain:      "input_datetime",
+		Noun:        "input datetime",
+		IconExample: "mdi:alarm",
+		Schema: map[string]*schema.Schema{
+			"has_date": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the input datetime holds a date. At least one of has_date and has_time must be true.",
+			},
+			"has_time": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the input datetime holds a time. At least one of has_date and has_time must be true.",
+			},
+			"initial": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Value when Home Assistant starts, formatted as 2024-12-24, 07:30:00 or 2024-12-24 07:30:00 to match has_date and has_time. If never set, the value from before the restart is restored.",
+			},
+		},
+		Expand:        expandInputDatetime,
+		Flatten:       flattenInputDatetime,
+		CustomizeDiff: resourceInputDatetimeCustomizeDiff,
+	}.resource()
+}
+
+// checkInputDatetime checks that the input datetime holds a date, a time or
+// both, and that the initial value, where set, is formatted accordingly.
+func checkInputDatetime(hasDate, hasTime bool, initial string) error {
+	if !hasDate && !hasTime {
+		return fmt.Errorf("at least one of has_date and has_time must be true")
+	}
+
+	if initial == "" {
+		return nil
+	}
+
+	var layouts []string
+	switch {
+	case hasDate && hasTime:
+		layouts = []string{"2006-01-02 15:04:05", "2006-01-02 15:04"}
+	case hasDate:
+		layouts = []string{"2006-01-02"}
+	default:
+		layouts = []string{"15:04:05", "15:04"}
+	}
+
+	for _, layout := range layouts {
+		if _, err := time.Parse(layout, initial); err == nil {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("initial %q must be formatted as %s", initial, layouts[0])
+}
+
+// resourceInputDatetimeCustomizeDiff validates the configuration at plan
+// time. Values that are not known yet are checked on a later plan.
+func resourceInputDatetimeCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("has_date") || !d.NewValueKnown("has_time") {
+		return nil
+	}
+
+	initial, _ := configuredString(d, "initial")
+
+	return checkInputDatetime(d.Get("has_date").(bool), d.Get("has_time").(bool), initial)
+}
+
+// expandInputDatetime adds the input datetime attributes to a storage collection payload.
+func expandInputDatetime(d *schema.ResourceData, payload map[string]interface{}) {
+	payload["has_date"] = d.Get("has_date").(bool)
+	payload["has_time"] = d.Get("has_time").(bool)
+
+	if initial := d.Get("initial").(string); initial != "" {
+		payload["initial"] = initial
+	}
+}
+
+// flattenInputDatetime sets the input datetime attributes from a stored item.
+func flattenInputDatetime(d *schema.ResourceData, item map[string]interface{}) {
+	hasDate, _ := item["has_date"].(bool)
+	hasTime, _ := item["has_time"].(bool)
+	initial, _ := item["initial"].(string)
+	d.Set("has_date", hasDate)
+	d.Set("has_time", hasTime)
+	d.Set("initial", initial)
+}
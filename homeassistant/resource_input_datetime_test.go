@@ -0,0 +1,124 @@
+package homeassistant
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceInputDatetime_Schema(t *testing.T) {
+	s := resourceInputDatetime().Schema
+
+	if !s["name"].Required {
+		t.Error("expected name to be required")
+	}
+
+	// Test optional fields
+	optionalFields := []string{"has_date", "has_time", "initial", "icon"}
+	for _, field := range optionalFields {
+		if !s[field].Optional {
+			t.Errorf("expected %s to be optional", field)
+		}
+	}
+
+	if !s["entity_id"].Computed {
+		t.Error("expected entity_id to be computed")
+	}
+
+	if resourceInputDatetime().Importer == nil {
+		t.Error("expected resource to have an importer")
+	}
+}
+
+func TestCheckInputDatetime(t *testing.T) {
+	tests := []struct {
+		name             string
+		hasDate, hasTime bool
+		initial          string
+		valid            bool
+	}{
+		{"neither date nor time", false, false, "", false},
+		{"date", true, false, "", true},
+		{"date initial", true, false, "2024-12-24", true},
+		{"date with time initial", true, false, "2024-12-24 07:30:00", false},
+		{"time initial", false, true, "07:30:00", true},
+		{"time initial without seconds", false, true, "07:30", true},
+		{"invalid time initial", false, true, "25:00", false},
+		{"datetime initial", true, true, "2024-12-24 07:30:00", true},
+		{"datetime with date initial", true, true, "2024-12-24", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkInputDatetime(tt.hasDate, tt.hasTime, tt.initial)
+			if tt.valid && err != nil {
+				t.Errorf("expected no error, got %v", err)
+			}
+			if !tt.valid && err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestExpandInputDatetime(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceInputDatetime().Schema, map[string]interface{}{
+		"name":     "Wake up",
+		"has_time": true,
+		"initial":  "07:30:00",
+	})
+
+	payload := map[string]interface{}{}
+	expandInputDatetime(d, payload)
+
+	expected := map[string]interface{}{
+		"has_date": false,
+		"has_time": true,
+		"initial":  "07:30:00",
+	}
+	if !reflect.DeepEqual(payload, expected) {
+		t.Errorf("expected payload %v, got %v", expected, payload)
+	}
+}
+
+// Acceptance tests - require a real Home Assistant instance
+
+func TestAccResourceInputDatetime_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceInputDatetimeConfig_basic("07:30:00"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("homeassistant_input_datetime.test", "has_time", "true"),
+					resource.TestCheckResourceAttr("homeassistant_input_datetime.test", "initial", "07:30:00"),
+					resource.TestCheckResourceAttrSet("homeassistant_input_datetime.test", "entity_id"),
+				),
+			},
+			{
+				Config: testAccResourceInputDatetimeConfig_basic("06:45:00"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("homeassistant_input_datetime.test", "initial", "06:45:00"),
+				),
+			},
+			{
+				ResourceName:      "homeassistant_input_datetime.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceInputDatetimeConfig_basic(initial string) string {
+	return `
+resource "homeassistant_input_datetime" "test" {
+  name     = "Terraform Wake Up"
+  has_time = true
+  initial  = "` + initial + `"
+}
+`
+}
@@ -0,0 +1,171 @@
+package homeassistant
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/dawwestk/terraform-provider-homeassistant/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceInputNumber() *schema.Resource {
+	return helperCollection{
+		Domain:      "input_number",
+		Noun:        "input number",
+		IconExample: "mdi:thermometer",
+		Schema: map[string]*schema.Schema{
+			"min": {
+				Type:        schema.TypeFloat,
+				Required:    true,
+				Description: "Minimum value. Must be less than max.",
+			},
+			"max": {
+				Type:        schema.TypeFloat,
+				Required:    true,
+				Description: "Maximum value. Must be greater than min.",
+			},
+			"step": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Default:      1.0,
+				ValidateFunc: validatePositiveFloat,
+				Description:  "Step between values. Must be greater than 0. Defaults to 1.",
+			},
+			"mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "slider",
+				ValidateFunc: validation.StringInSlice([]string{"box", "slider"}, false),
+				Description:  "How the input number is displayed: 'box' or 'slider'. Defaults to slider.",
+			},
+			"unit_of_measurement": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Unit of the value (e.g., °C). Home Assistant keeps the current unit when removed from the configuration.",
+			},
+			"initial": {
+				Type:        schema.TypeFloat,
+				Optional:    true,
+				Computed:    true,
+				Description: "Value of the input number when Home Assistant starts. If never set, the value from before the restart is restored.",
+			},
+			"value": {
+				Type:        schema.TypeFloat,
+				Optional:    true,
+				Computed:    true,
+				Description: "Desired current value, applied through input_number.set_value. If not specified, the value is left to automations and only read.",
+			},
+		},
+		Expand:        expandInputNumber,
+		Flatten:       flattenInputNumber,
+		AfterWrite:    applyInputNumberValue,
+		FlattenState:  flattenInputNumberState,
+		CustomizeDiff: resourceInputNumberCustomizeDiff,
+	}.resource()
+}
+
+// validatePositiveFloat checks that a value is a number greater than 0.
+func validatePositiveFloat(v interface{}, k string) ([]string, []error) {
+	f, ok := v.(float64)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected %s to be a number", k)}
+	}
+
+	if f <= 0 {
+		return nil, []error{fmt.Errorf("expected %s to be greater than 0, got %v", k, f)}
+	}
+
+	return nil, nil
+}
+
+// checkInputNumberRange checks that min is below max and that the initial and
+// desired values, where set, lie within them.
+func checkInputNumberRange(min, max float64, initial, value *float64) error {
+	if min >= max {
+		return fmt.Errorf("min (%v) must be less than max (%v)", min, max)
+	}
+	if initial != nil && (*initial < min || *initial > max) {
+		return fmt.Errorf("initial (%v) must be between min (%v) and max (%v)", *initial, min, max)
+	}
+	if value != nil && (*value < min || *value > max) {
+		return fmt.Errorf("value (%v) must be between min (%v) and max (%v)", *value, min, max)
+	}
+	return nil
+}
+
+// resourceInputNumberCustomizeDiff validates the range at plan time. Values
+// that are not known yet are checked on a later plan.
+func resourceInputNumberCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	min, minKnown := configuredFloat(d, "min")
+	max, maxKnown := configuredFloat(d, "max")
+	if !minKnown || !maxKnown {
+		return nil
+	}
+
+	var initial, value *float64
+	if v, ok := configuredFloat(d, "initial"); ok {
+		initial = &v
+	}
+	if v, ok := configuredFloat(d, "value"); ok {
+		value = &v
+	}
+
+	return checkInputNumberRange(min, max, initial, value)
+}
+
+// expandInputNumber adds the input number attributes to a storage collection payload.
+func expandInputNumber(d *schema.ResourceData, payload map[string]interface{}) {
+	payload["min"] = d.Get("min").(float64)
+	payload["max"] = d.Get("max").(float64)
+	payload["step"] = d.Get("step").(float64)
+	payload["mode"] = d.Get("mode").(string)
+
+	if unit := d.Get("unit_of_measurement").(string); unit != "" {
+		payload["unit_of_measurement"] = unit
+	}
+	if isConfigured(d, "initial") {
+		payload["initial"] = d.Get("initial").(float64)
+	}
+}
+
+// flattenInputNumber sets the input number attributes from a stored item.
+func flattenInputNumber(d *schema.ResourceData, item map[string]interface{}) {
+	for _, key := range []string{"min", "max", "step", "initial"} {
+		if v, ok := toFloat(item[key]); ok {
+			d.Set(key, v)
+		}
+	}
+
+	mode, _ := item["mode"].(string)
+	unit, _ := item["unit_of_measurement"].(string)
+	d.Set("mode", mode)
+	d.Set("unit_of_measurement", unit)
+}
+
+// applyInputNumberValue calls input_number.set_value for the entity when the
+// desired value is set on create or changed.
+func applyInputNumberValue(ctx context.Context, c *client.Client, d *schema.ResourceData) error {
+	if !isConfigured(d, "value") || (!d.IsNewResource() && !d.HasChange("value")) {
+		return nil
+	}
+
+	// Setting an absolute value is safe to retry
+	_, err := c.CallServiceContext(client.WithRetrySafe(ctx), "input_number", "set_value", map[string]interface{}{
+		"entity_id": d.Get("entity_id").(string),
+		"value":     d.Get("value").(float64),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set input number value: %w", err)
+	}
+	return nil
+}
+
+// flattenInputNumberState sets the current value of the input number.
+func flattenInputNumberState(d *schema.ResourceData, state *client.State) {
+	if value, err := strconv.ParseFloat(state.State, 64); err == nil {
+		d.Set("value", value)
+	}
+}
@@ -0,0 +1,189 @@
+package homeassistant
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/dawwestk/terraform-provider-homeassistant/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceInputNumber_Schema(t *testing.T) {
+	s := resourceInputNumber().Schema
+
+	// Test required fields
+	requiredFields := []string{"name", "min", "max"}
+	for _, field := range requiredFields {
+		if !s[field].Required {
+			t.Errorf("expected %s to be required", field)
+		}
+	}
+
+	// Test optional fields
+	optionalFields := []string{"step", "mode", "unit_of_measurement", "icon", "initial", "value"}
+	for _, field := range optionalFields {
+		if !s[field].Optional {
+			t.Errorf("expected %s to be optional", field)
+		}
+	}
+
+	if !s["entity_id"].Computed {
+		t.Error("expected entity_id to be computed")
+	}
+
+	if resourceInputNumber().CustomizeDiff == nil {
+		t.Error("expected the range to be validated at plan time")
+	}
+}
+
+func TestResourceInputNumber_StepValidation(t *testing.T) {
+	s := resourceInputNumber().Schema["step"]
+
+	for _, v := range []float64{0.1, 1, 5} {
+		if _, errs := s.ValidateFunc(v, "step"); len(errs) > 0 {
+			t.Errorf("expected %v to be valid, got %v", v, errs)
+		}
+	}
+	for _, v := range []float64{0, -1} {
+		if _, errs := s.ValidateFunc(v, "step"); len(errs) == 0 {
+			t.Errorf("expected %v to be invalid", v)
+		}
+	}
+}
+
+func TestResourceInputNumber_ModeValidation(t *testing.T) {
+	s := resourceInputNumber().Schema["mode"]
+
+	if _, errs := s.ValidateFunc("box", "mode"); len(errs) > 0 {
+		t.Errorf("expected 'box' to be valid, got %v", errs)
+	}
+	if _, errs := s.ValidateFunc("dial", "mode"); len(errs) == 0 {
+		t.Error("expected 'dial' to be invalid")
+	}
+}
+
+func TestCheckInputNumberRange(t *testing.T) {
+	inRange, below, above := 21.0, 4.0, 31.0
+
+	tests := []struct {
+		name     string
+		min, max float64
+		initial  *float64
+		value    *float64
+		valid    bool
+	}{
+		{"valid range", 5, 30, nil, nil, true},
+		{"min equals max", 5, 5, nil, nil, false},
+		{"min above max", 30, 5, nil, nil, false},
+		{"initial in range", 5, 30, &inRange, nil, true},
+		{"initial below min", 5, 30, &below, nil, false},
+		{"value in range", 5, 30, nil, &inRange, true},
+		{"value above max", 5, 30, nil, &above, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkInputNumberRange(tt.min, tt.max, tt.initial, tt.value)
+			if tt.valid && err != nil {
+				t.Errorf("expected no error, got %v", err)
+			}
+			if !tt.valid && err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestExpandInputNumber(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceInputNumber().Schema, map[string]interface{}{
+		"name":                "Target temperature",
+		"min":                 5.0,
+		"max":                 30.0,
+		"step":                0.5,
+		"unit_of_measurement": "°C",
+		"initial":             21.0,
+	})
+
+	payload := map[string]interface{}{}
+	expandInputNumber(d, payload)
+
+	expected := map[string]interface{}{
+		"min":                 5.0,
+		"max":                 30.0,
+		"step":                0.5,
+		"mode":                "slider",
+		"unit_of_measurement": "°C",
+		"initial":             21.0,
+	}
+	if !reflect.DeepEqual(payload, expected) {
+		t.Errorf("expected payload %v, got %v", expected, payload)
+	}
+}
+
+func TestFlattenInputNumber(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceInputNumber().Schema, map[string]interface{}{})
+
+	flattenInputNumber(d, map[string]interface{}{
+		"min":  float64(5),
+		"max":  float64(30),
+		"step": 0.5,
+		"mode": "box",
+	})
+	flattenInputNumberState(d, &client.State{State: "21.5"})
+
+	if d.Get("min") != 5.0 || d.Get("max") != 30.0 || d.Get("step") != 0.5 {
+		t.Errorf("unexpected range min=%v max=%v step=%v", d.Get("min"), d.Get("max"), d.Get("step"))
+	}
+	if d.Get("mode") != "box" {
+		t.Errorf("expected mode 'box', got %v", d.Get("mode"))
+	}
+	if d.Get("value") != 21.5 {
+		t.Errorf("expected value 21.5, got %v", d.Get("value"))
+	}
+}
+
+// Acceptance tests - require a real Home Assistant instance
+
+func TestAccResourceInputNumber_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceInputNumberConfig_basic(20),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("homeassistant_input_number.test", "name", "Terraform Target Temperature"),
+					resource.TestCheckResourceAttr("homeassistant_input_number.test", "value", "20"),
+					resource.TestCheckResourceAttrSet("homeassistant_input_number.test", "entity_id"),
+				),
+			},
+			{
+				Config: testAccResourceInputNumberConfig_basic(21.5),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("homeassistant_input_number.test", "value", "21.5"),
+				),
+			},
+			{
+				ResourceName:      "homeassistant_input_number.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceInputNumberConfig_basic(value float64) string {
+	return fmt.Sprintf(`
+resource "homeassistant_input_number" "test" {
+  name                = "Terraform Target Temperature"
+  min                 = 5
+  max                 = 30
+  step                = 0.5
+  mode                = "box"
+  unit_of_measurement = "°C"
+  value               = %v
+}
+`, value)
+}
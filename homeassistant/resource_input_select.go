@@ -0,0 +1,101 @@
+package homeassistant
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/dawwestk/terraform-provider-homeassistant/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceInputSelect() *schema.Resource {
+	return helperCollection{
+		Domain:      "input_select",
+		Noun:        "input select",
+		IconExample: "mdi:format-list-bulleted",
+		Schema: map[string]*schema.Schema{
+			"options": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "Options to choose from, in display order. Must be unique. Changing them updates the helper in place.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+			"initial": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Option selected when Home Assistant starts. Must be one of options. If never set, the option selected before the restart is restored.",
+			},
+			// Computed attributes
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The currently selected option.",
+			},
+		},
+		Expand:        expandInputSelect,
+		Flatten:       flattenInputSelect,
+		FlattenState:  flattenInputSelectState,
+		CustomizeDiff: resourceInputSelectCustomizeDiff,
+	}.resource()
+}
+
+// checkInputSelectOptions checks that the options are unique and that the
+// initial option, where set, is one of them.
+func checkInputSelectOptions(options []string, initial string) error {
+	for i, option := range options {
+		if slices.Contains(options[:i], option) {
+			return fmt.Errorf("option %q is listed more than once", option)
+		}
+	}
+
+	if initial != "" && !slices.Contains(options, initial) {
+		return fmt.Errorf("initial %q must be one of the options", initial)
+	}
+
+	return nil
+}
+
+// resourceInputSelectCustomizeDiff validates the options at plan time. Values
+// that are not known yet are checked on a later plan.
+func resourceInputSelectCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	options, ok := configuredStrings(d, "options")
+	if !ok {
+		return nil
+	}
+
+	initial, _ := configuredString(d, "initial")
+
+	return checkInputSelectOptions(options, initial)
+}
+
+// expandInputSelect adds the input select attributes to a storage collection
+// payload. Updating the options in place keeps the entity ID, so automations
+// referring to it keep working. Updates are merged into the stored item, so an
+// unset initial is sent as null to clear one that may no longer be an option.
+func expandInputSelect(d *schema.ResourceData, payload map[string]interface{}) {
+	payload["options"] = interfaceStrings(d.Get("options"))
+
+	payload["initial"] = nil
+	if isConfigured(d, "initial") {
+		payload["initial"] = client.NullableString(d.Get("initial").(string))
+	}
+}
+
+// flattenInputSelect sets the input select attributes from a stored item.
+func flattenInputSelect(d *schema.ResourceData, item map[string]interface{}) {
+	initial, _ := item["initial"].(string)
+	d.Set("options", interfaceStrings(item["options"]))
+	d.Set("initial", initial)
+}
+
+// flattenInputSelectState sets the currently selected option.
+func flattenInputSelectState(d *schema.ResourceData, state *client.State) {
+	d.Set("state", state.State)
+}
@@ -0,0 +1,152 @@
+package homeassistant
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestResourceInputSelect_Schema(t *testing.T) {
+	s := resourceInputSelect().Schema
+
+	// Test required fields
+	requiredFields := []string{"name", "options"}
+	for _, field := range requiredFields {
+		if !s[field].Required {
+			t.Errorf("expected %s to be required", field)
+		}
+	}
+
+	// Options are updated in place to preserve the entity ID
+	for name, field := range s {
+		if field.ForceNew {
+			t.Errorf("expected %s not to force a new resource", name)
+		}
+	}
+
+	if s["options"].Type != schema.TypeList {
+		t.Error("expected options to be an ordered list")
+	}
+
+	// Test computed fields
+	computedFields := []string{"entity_id", "state"}
+	for _, field := range computedFields {
+		if !s[field].Computed {
+			t.Errorf("expected %s to be computed", field)
+		}
+	}
+}
+
+func TestCheckInputSelectOptions(t *testing.T) {
+	options := []string{"comfort", "eco", "away"}
+
+	if err := checkInputSelectOptions(options, ""); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	if err := checkInputSelectOptions(options, "eco"); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	if err := checkInputSelectOptions(options, "boost"); err == nil {
+		t.Error("expected error for initial not in options")
+	}
+	if err := checkInputSelectOptions([]string{"eco", "away", "eco"}, ""); err == nil {
+		t.Error("expected error for duplicate options")
+	}
+}
+
+func TestExpandInputSelect(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceInputSelect().Schema, map[string]interface{}{
+		"name":    "Heating mode",
+		"options": []interface{}{"comfort", "eco", "away"},
+		"initial": "eco",
+	})
+
+	payload := map[string]interface{}{}
+	expandInputSelect(d, payload)
+
+	expected := map[string]interface{}{
+		"options": []string{"comfort", "eco", "away"},
+		"initial": "eco",
+	}
+	if !reflect.DeepEqual(payload, expected) {
+		t.Errorf("expected payload %v, got %v", expected, payload)
+	}
+}
+
+func TestExpandInputSelect_RemovedInitialOption(t *testing.T) {
+	// The stored initial is kept in state while it is not configured, and the
+	// update drops the option holding it
+	state := &terraform.InstanceState{
+		ID: "heating_mode",
+		Attributes: map[string]string{
+			"id":        "heating_mode",
+			"name":      "Heating mode",
+			"options.#": "2",
+			"options.0": "comfort",
+			"options.1": "away",
+			"initial":   "eco",
+		},
+		RawConfig: cty.ObjectVal(map[string]cty.Value{
+			"name":    cty.StringVal("Heating mode"),
+			"options": cty.ListVal([]cty.Value{cty.StringVal("comfort"), cty.StringVal("away")}),
+			"initial": cty.NullVal(cty.String),
+		}),
+	}
+	d := resourceInputSelect().Data(state)
+
+	payload := map[string]interface{}{}
+	expandInputSelect(d, payload)
+
+	expected := map[string]interface{}{
+		"options": []string{"comfort", "away"},
+		"initial": nil,
+	}
+	if !reflect.DeepEqual(payload, expected) {
+		t.Errorf("expected payload %v, got %v", expected, payload)
+	}
+}
+
+// Acceptance tests - require a real Home Assistant instance
+
+func TestAccResourceInputSelect_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceInputSelectConfig_basic(`["comfort", "eco"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("homeassistant_input_select.test", "options.#", "2"),
+					resource.TestCheckResourceAttr("homeassistant_input_select.test", "state", "eco"),
+					resource.TestCheckResourceAttrSet("homeassistant_input_select.test", "entity_id"),
+				),
+			},
+			{
+				Config: testAccResourceInputSelectConfig_basic(`["comfort", "eco", "away"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("homeassistant_input_select.test", "options.#", "3"),
+					resource.TestCheckResourceAttr("homeassistant_input_select.test", "options.2", "away"),
+				),
+			},
+			{
+				ResourceName:      "homeassistant_input_select.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceInputSelectConfig_basic(options string) string {
+	return `
+resource "homeassistant_input_select" "test" {
+  name    = "Terraform Heating Mode"
+  options = ` + options + `
+  initial = "eco"
+}
+`
+}
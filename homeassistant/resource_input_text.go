@@ -0,0 +1,112 @@
+package homeassistant
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// inputTextMaxLength is the longest state Home Assistant can store.
+const inputTextMaxLength = 255
+
+func resourceInputText() *schema.Resource {
+	return helperCollection{
+		Domain:      "input_text",
+		Noun:        "input text",
+		IconExample: "mdi:form-textbox",
+		Schema: map[string]*schema.Schema{
+			"min": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, inputTextMaxLength),
+				Description:  "Minimum length of the text. Defaults to 0.",
+			},
+			"max": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      100,
+				ValidateFunc: validation.IntBetween(0, inputTextMaxLength),
+				Description:  "Maximum length of the text, at most 255. Defaults to 100.",
+			},
+			"pattern": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Regular expression the text must match, in Python syntax. Home Assistant keeps the current pattern when removed from the configuration.",
+			},
+			"mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "text",
+				ValidateFunc: validation.StringInSlice([]string{"text", "password"}, false),
+				Description:  "How the input text is displayed: 'text' or 'password'. Defaults to text.",
+			},
+			"initial": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Text when Home Assistant starts. If never set, the text from before the restart is restored.",
+			},
+		},
+		Expand:        expandInputText,
+		Flatten:       flattenInputText,
+		CustomizeDiff: resourceInputTextCustomizeDiff,
+	}.resource()
+}
+
+// checkInputTextLength checks that min does not exceed max and that the
+// initial text, where set, fits between them.
+func checkInputTextLength(min, max int, initial string) error {
+	if min > max {
+		return fmt.Errorf("min (%d) must not be greater than max (%d)", min, max)
+	}
+
+	if initial != "" {
+		if n := len([]rune(initial)); n < min || n > max {
+			return fmt.Errorf("initial must be between %d and %d characters long, got %d", min, max, n)
+		}
+	}
+
+	return nil
+}
+
+// resourceInputTextCustomizeDiff validates the lengths at plan time. Values
+// that are not known yet are checked on a later plan.
+func resourceInputTextCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("min") || !d.NewValueKnown("max") {
+		return nil
+	}
+
+	initial, _ := configuredString(d, "initial")
+
+	return checkInputTextLength(d.Get("min").(int), d.Get("max").(int), initial)
+}
+
+// expandInputText adds the input text attributes to a storage collection payload.
+func expandInputText(d *schema.ResourceData, payload map[string]interface{}) {
+	payload["min"] = d.Get("min").(int)
+	payload["max"] = d.Get("max").(int)
+	payload["mode"] = d.Get("mode").(string)
+
+	for _, key := range []string{"pattern", "initial"} {
+		if v := d.Get(key).(string); v != "" {
+			payload[key] = v
+		}
+	}
+}
+
+// flattenInputText sets the input text attributes from a stored item.
+func flattenInputText(d *schema.ResourceData, item map[string]interface{}) {
+	for _, key := range []string{"min", "max"} {
+		if v, ok := itemInt(item, key); ok {
+			d.Set(key, v)
+		}
+	}
+	for _, key := range []string{"pattern", "mode", "initial"} {
+		v, _ := item[key].(string)
+		d.Set(key, v)
+	}
+}
@@ -0,0 +1,138 @@
+package homeassistant
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceInputText_Schema(t *testing.T) {
+	s := resourceInputText().Schema
+
+	if !s["name"].Required {
+		t.Error("expected name to be required")
+	}
+
+	// Test optional fields
+	optionalFields := []string{"min", "max", "pattern", "mode", "initial", "icon"}
+	for _, field := range optionalFields {
+		if !s[field].Optional {
+			t.Errorf("expected %s to be optional", field)
+		}
+	}
+
+	if !s["entity_id"].Computed {
+		t.Error("expected entity_id to be computed")
+	}
+}
+
+func TestResourceInputText_HasImporter(t *testing.T) {
+	r := resourceInputText()
+	if r.Importer == nil {
+		t.Error("expected resource to have an importer")
+	}
+}
+
+func TestResourceInputText_MaxValidation(t *testing.T) {
+	s := resourceInputText().Schema["max"]
+
+	if _, errs := s.ValidateFunc(255, "max"); len(errs) > 0 {
+		t.Errorf("expected 255 to be valid, got %v", errs)
+	}
+	if _, errs := s.ValidateFunc(256, "max"); len(errs) == 0 {
+		t.Error("expected 256 to be invalid")
+	}
+}
+
+func TestResourceInputText_ModeValidation(t *testing.T) {
+	s := resourceInputText().Schema["mode"]
+
+	if _, errs := s.ValidateFunc("password", "mode"); len(errs) > 0 {
+		t.Errorf("expected 'password' to be valid, got %v", errs)
+	}
+	if _, errs := s.ValidateFunc("secret", "mode"); len(errs) == 0 {
+		t.Error("expected 'secret' to be invalid")
+	}
+}
+
+func TestCheckInputTextLength(t *testing.T) {
+	if err := checkInputTextLength(0, 100, ""); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	if err := checkInputTextLength(5, 5, "hello"); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	if err := checkInputTextLength(10, 5, ""); err == nil {
+		t.Error("expected error for min greater than max")
+	}
+	if err := checkInputTextLength(0, 3, "hello"); err == nil {
+		t.Error("expected error for initial longer than max")
+	}
+	if err := checkInputTextLength(0, 4, "été!"); err != nil {
+		t.Errorf("expected length to be counted in characters, got %v", err)
+	}
+}
+
+func TestExpandInputText(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceInputText().Schema, map[string]interface{}{
+		"name":    "Alarm code",
+		"max":     8,
+		"pattern": "[0-9]*",
+		"mode":    "password",
+	})
+
+	payload := map[string]interface{}{}
+	expandInputText(d, payload)
+
+	expected := map[string]interface{}{
+		"min":     0,
+		"max":     8,
+		"mode":    "password",
+		"pattern": "[0-9]*",
+	}
+	if !reflect.DeepEqual(payload, expected) {
+		t.Errorf("expected payload %v, got %v", expected, payload)
+	}
+}
+
+// Acceptance tests - require a real Home Assistant instance
+
+func TestAccResourceInputText_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceInputTextConfig_basic(50),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("homeassistant_input_text.test", "max", "50"),
+					resource.TestCheckResourceAttrSet("homeassistant_input_text.test", "entity_id"),
+				),
+			},
+			{
+				Config: testAccResourceInputTextConfig_basic(120),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("homeassistant_input_text.test", "max", "120"),
+				),
+			},
+			{
+				ResourceName:      "homeassistant_input_text.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceInputTextConfig_basic(max int) string {
+	return fmt.Sprintf(`
+resource "homeassistant_input_text" "test" {
+  name    = "Terraform Holiday Message"
+  max     = %d
+  initial = "Back soon"
+}
+`, max)
+}
@@ -0,0 +1,134 @@
+package homeassistant
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dawwestk/terraform-provider-homeassistant/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceLabel() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLabelCreate,
+		ReadContext:   resourceLabelRead,
+		UpdateContext: resourceLabelUpdate,
+		DeleteContext: resourceLabelDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "Name of the label.",
+			},
+			"color": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Color of the label, either a theme color name (e.g., red, indigo) or a hex value (e.g., #ff0000).",
+			},
+			"icon": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "MDI icon for the label (e.g., mdi:alert).",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the label.",
+			},
+			// Computed attributes
+			"label_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the label, derived from its name at creation (e.g., guest_mode).",
+			},
+		},
+	}
+}
+
+// labelFromResourceData builds a label registry entry from the resource data.
+func labelFromResourceData(d *schema.ResourceData) client.Label {
+	return client.Label{
+		Name:        d.Get("name").(string),
+		Color:       d.Get("color").(string),
+		Icon:        d.Get("icon").(string),
+		Description: d.Get("description").(string),
+	}
+}
+
+func resourceLabelCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	label, err := c.CreateLabelContext(ctx, labelFromResourceData(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(label.LabelID)
+
+	return resourceLabelRead(ctx, d, m)
+}
+
+func resourceLabelRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	var diags diag.Diagnostics
+
+	label, err := c.GetLabelContext(ctx, d.Id())
+	if err != nil {
+		if client.IsNotFound(err) {
+			// The label was deleted outside of Terraform
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(fmt.Errorf("failed to read label: %w", err))
+	}
+
+	d.Set("label_id", label.LabelID)
+	d.Set("name", label.Name)
+	d.Set("color", label.Color)
+	d.Set("icon", label.Icon)
+	d.Set("description", label.Description)
+
+	return diags
+}
+
+func resourceLabelUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	_, err := c.UpdateLabelContext(ctx, d.Id(), labelFromResourceData(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceLabelRead(ctx, d, m)
+}
+
+func resourceLabelDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	var diags diag.Diagnostics
+
+	err := c.DeleteLabelContext(ctx, d.Id())
+	if err != nil && !client.IsNotFound(err) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,93 @@
+package homeassistant
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceLabel_Schema(t *testing.T) {
+	s := resourceLabel().Schema
+
+	if !s["name"].Required {
+		t.Error("expected name to be required")
+	}
+
+	// Test optional fields
+	optionalFields := []string{"color", "icon", "description"}
+	for _, field := range optionalFields {
+		if !s[field].Optional {
+			t.Errorf("expected %s to be optional", field)
+		}
+	}
+
+	if !s["label_id"].Computed {
+		t.Error("expected label_id to be computed")
+	}
+}
+
+func TestResourceLabel_HasImporter(t *testing.T) {
+	r := resourceLabel()
+	if r.Importer == nil {
+		t.Error("expected resource to have an importer")
+	}
+}
+
+func TestLabelFromResourceData(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceLabel().Schema, map[string]interface{}{
+		"name":  "Critical",
+		"color": "red",
+		"icon":  "mdi:alert",
+	})
+
+	label := labelFromResourceData(d)
+
+	if label.Name != "Critical" || label.Color != "red" || label.Icon != "mdi:alert" {
+		t.Errorf("unexpected label: %+v", label)
+	}
+	if label.Description != "" {
+		t.Errorf("expected empty description, got %q", label.Description)
+	}
+}
+
+// Acceptance tests - require a real Home Assistant instance
+
+func TestAccResourceLabel_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceLabelConfig_basic("red"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("homeassistant_label.test", "name", "Terraform Test Label"),
+					resource.TestCheckResourceAttr("homeassistant_label.test", "label_id", "terraform_test_label"),
+					resource.TestCheckResourceAttr("homeassistant_label.test", "color", "red"),
+				),
+			},
+			{
+				Config: testAccResourceLabelConfig_basic("indigo"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("homeassistant_label.test", "color", "indigo"),
+				),
+			},
+			{
+				ResourceName:      "homeassistant_label.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceLabelConfig_basic(color string) string {
+	return `
+resource "homeassistant_label" "test" {
+  name        = "Terraform Test Label"
+  color       = "` + color + `"
+  icon        = "mdi:tag"
+  description = "Managed by Terraform acceptance tests"
+}
+`
+}
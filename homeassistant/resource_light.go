@@ -3,6 +3,7 @@ package homeassistant
 import (
 	"context"
 	"fmt"
+	"math"
 	"time"
 
 	"github.com/dawwestk/terraform-provider-homeassistant/client"
@@ -11,10 +12,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-// stateSettleDelay is the time to wait after calling a service
-// to allow Home Assistant to update the entity state
-const stateSettleDelay = 500 * time.Millisecond
-
 func resourceLight() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceLightCreate,
@@ -26,6 +23,12 @@ func resourceLight() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"entity_id": {
 				Type:        schema.TypeString,
@@ -84,6 +87,12 @@ func resourceLight() *schema.Resource {
 				Computed:    true,
 				Description: "Light effect name.",
 			},
+			"wait_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 600),
+				Description:  "Seconds to wait for the light to report the desired state after a change. Defaults to 10. Set to 0 to skip waiting.",
+			},
 		},
 	}
 }
@@ -110,17 +119,21 @@ func resourceLightCreate(ctx context.Context, d *schema.ResourceData, m interfac
 		service = "turn_off"
 	}
 
-	_, err := c.CallService("light", service, serviceData)
+	// Turning a light on or off sets an absolute state, so it is safe to retry
+	_, err := c.CallServiceContext(client.WithRetrySafe(ctx), "light", service, serviceData)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to set light state: %w", err))
 	}
 
-	// Wait for Home Assistant to update the state
-	time.Sleep(stateSettleDelay)
-
 	d.SetId(entityID)
 
-	return resourceLightRead(ctx, d, m)
+	// Wait for the light to report the new state before reading it back
+	diags := waitForState(ctx, c, entityID, desiredLightState(state, serviceData), stateWaitTimeout(d))
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, resourceLightRead(ctx, d, m)...)
 }
 
 func resourceLightRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -130,8 +143,13 @@ func resourceLightRead(ctx context.Context, d *schema.ResourceData, m interface{
 
 	entityID := d.Id()
 
-	haState, err := c.GetState(entityID)
+	haState, err := c.GetStateContext(ctx, entityID)
 	if err != nil {
+		if client.IsNotFound(err) {
+			// The light no longer exists in Home Assistant
+			d.SetId("")
+			return diags
+		}
 		return diag.FromErr(fmt.Errorf("failed to read light state: %w", err))
 	}
 
@@ -201,15 +219,18 @@ func resourceLightUpdate(ctx context.Context, d *schema.ResourceData, m interfac
 		service = "turn_off"
 	}
 
-	_, err := c.CallService("light", service, serviceData)
+	_, err := c.CallServiceContext(client.WithRetrySafe(ctx), "light", service, serviceData)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to update light state: %w", err))
 	}
 
-	// Wait for Home Assistant to update the state
-	time.Sleep(stateSettleDelay)
+	// Wait for the light to report the new state before reading it back
+	diags := waitForState(ctx, c, d.Id(), desiredLightState(state, serviceData), stateWaitTimeout(d))
+	if diags.HasError() {
+		return diags
+	}
 
-	return resourceLightRead(ctx, d, m)
+	return append(diags, resourceLightRead(ctx, d, m)...)
 }
 
 func resourceLightDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -224,7 +245,7 @@ func resourceLightDelete(ctx context.Context, d *schema.ResourceData, m interfac
 		"entity_id": entityID,
 	}
 
-	_, err := c.CallService("light", "turn_off", serviceData)
+	_, err := c.CallServiceContext(client.WithRetrySafe(ctx), "light", "turn_off", serviceData)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to turn off light: %w", err))
 	}
@@ -287,3 +308,29 @@ func buildLightServiceData(d *schema.ResourceData) map[string]interface{} {
 
 	return serviceData
 }
+
+// desiredLightState derives the state the light should report once the
+// service call has been applied. Attributes are only checked when turning on.
+func desiredLightState(state string, serviceData map[string]interface{}) desiredState {
+	desired := desiredState{State: state}
+
+	if state != "on" {
+		return desired
+	}
+
+	desired.Attributes = map[string]interface{}{}
+	for _, name := range []string{"brightness", "rgb_color", "color_temp_kelvin", "effect"} {
+		if v, ok := serviceData[name]; ok {
+			desired.Attributes[name] = v
+		}
+	}
+
+	// Home Assistant reports brightness on the 0-255 scale only
+	if pct, ok := serviceData["brightness_pct"].(int); ok {
+		if _, ok := desired.Attributes["brightness"]; !ok {
+			desired.Attributes["brightness"] = int(math.Round(float64(pct) * 255 / 100))
+		}
+	}
+
+	return desired
+}
@@ -1,11 +1,16 @@
 package homeassistant
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 // getTestLightEntityID returns the light entity ID to use for tests.
@@ -37,6 +42,16 @@ func TestResourceLight_Schema(t *testing.T) {
 	}
 }
 
+func TestResourceLight_HasTimeouts(t *testing.T) {
+	r := resourceLight()
+	if r.Timeouts == nil {
+		t.Fatal("expected resource to have timeouts")
+	}
+	if r.Timeouts.Create == nil || r.Timeouts.Update == nil || r.Timeouts.Delete == nil {
+		t.Error("expected create, update and delete timeouts to be set")
+	}
+}
+
 func TestResourceLight_HasImporter(t *testing.T) {
 	r := resourceLight()
 	if r.Importer == nil {
@@ -122,6 +137,93 @@ func TestResourceLight_RGBColorMaxItems(t *testing.T) {
 	}
 }
 
+func TestResourceLight_WaitTimeoutAbsentFromStatePlansNoChanges(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "light.desk",
+		Attributes: map[string]string{
+			"id":                "light.desk",
+			"entity_id":         "light.desk",
+			"state":             "on",
+			"brightness":        "255",
+			"rgb_color.#":       "0",
+			"color_temp_kelvin": "0",
+			"effect":            "",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"entity_id": "light.desk",
+		"state":     "on",
+	})
+
+	diff, err := resourceLight().Diff(context.Background(), state, config, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff != nil && !diff.Empty() {
+		t.Errorf("expected no changes, got %v", diff.Attributes)
+	}
+}
+
+func TestResourceLightRead_RemovesMissingLight(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"message": "Entity not found."}`))
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceLight().Schema, map[string]interface{}{})
+	d.SetId("light.gone")
+
+	diags := resourceLightRead(context.Background(), d, testClient(server))
+	if diags.HasError() {
+		t.Fatalf("expected no error, got %v", diags)
+	}
+	if d.Id() != "" {
+		t.Errorf("expected light to be removed from state, ID is %q", d.Id())
+	}
+}
+
+func TestResourceLightRead_KeepsLightOnServerError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceLight().Schema, map[string]interface{}{})
+	d.SetId("light.desk")
+
+	diags := resourceLightRead(context.Background(), d, testClient(server))
+	if !diags.HasError() {
+		t.Fatal("expected error for 502 response")
+	}
+	if d.Id() != "light.desk" {
+		t.Errorf("expected light to remain in state, ID is %q", d.Id())
+	}
+}
+
+func TestDesiredLightState(t *testing.T) {
+	desired := desiredLightState("on", map[string]interface{}{
+		"entity_id":      "light.desk",
+		"brightness_pct": 50,
+		"transition":     2.0,
+	})
+
+	if desired.State != "on" {
+		t.Errorf("expected state 'on', got %q", desired.State)
+	}
+	if desired.Attributes["brightness"] != 128 {
+		t.Errorf("expected brightness_pct 50 to map to brightness 128, got %v", desired.Attributes["brightness"])
+	}
+	if _, ok := desired.Attributes["transition"]; ok {
+		t.Error("expected transition not to be checked")
+	}
+
+	off := desiredLightState("off", map[string]interface{}{"brightness": 200})
+	if len(off.Attributes) != 0 {
+		t.Errorf("expected no attributes to be checked when turning off, got %v", off.Attributes)
+	}
+}
+
 // Acceptance tests - require a real Home Assistant instance
 // Run with: TF_ACC=1 HA_TEST_LIGHT_ENTITY=light.your_light go test -v ./homeassistant/
 
@@ -207,7 +309,7 @@ func TestAccResourceLight_import(t *testing.T) {
 				ResourceName:            "homeassistant_light.test",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"state", "brightness", "rgb_color"},
+				ImportStateVerifyIgnore: []string{"state", "brightness", "rgb_color", "wait_timeout"},
 			},
 		},
 	})
@@ -0,0 +1,256 @@
+package homeassistant
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dawwestk/terraform-provider-homeassistant/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceScene() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSceneCreate,
+		ReadContext:   resourceSceneRead,
+		UpdateContext: resourceSceneUpdate,
+		DeleteContext: resourceSceneDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"scene_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "Unique ID of the scene. Generated if not set.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the scene.",
+			},
+			"icon": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "MDI icon for the scene (e.g., mdi:movie-open).",
+			},
+			"entities": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateSceneEntities,
+				DiffSuppressFunc: suppressEquivalentDocument,
+				Description:      "Desired state of each entity in the scene, as a YAML or JSON object keyed by entity ID. Values are either a state or an object with a state and attributes.",
+			},
+			"activate_on_apply": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If true, the scene is activated after it is created or updated. Defaults to false.",
+			},
+			// Computed attributes
+			"entity_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The entity ID of the scene (e.g., scene.movie_night).",
+			},
+		},
+	}
+}
+
+// validateSceneEntities checks that a value is a YAML or JSON object keyed by entity ID.
+func validateSceneEntities(v interface{}, k string) ([]string, []error) {
+	warnings, errs := validateDocument(v, k)
+	if len(errs) > 0 {
+		return warnings, errs
+	}
+
+	parsed, _ := parseDocument(v.(string))
+	entities, ok := parsed.(map[string]interface{})
+	if !ok || len(entities) == 0 {
+		return warnings, []error{fmt.Errorf("%s must be a non-empty object keyed by entity ID", k)}
+	}
+
+	for entityID := range entities {
+		if !strings.Contains(entityID, ".") {
+			errs = append(errs, fmt.Errorf("%s: %q is not an entity ID", k, entityID))
+		}
+	}
+
+	return warnings, errs
+}
+
+// sceneConfigFromResourceData builds the scene configuration from the resource data.
+func sceneConfigFromResourceData(d *schema.ResourceData, sceneID string) (map[string]interface{}, error) {
+	config := map[string]interface{}{
+		"id":   sceneID,
+		"name": d.Get("name").(string),
+	}
+
+	if icon := d.Get("icon").(string); icon != "" {
+		config["icon"] = icon
+	}
+
+	entities, err := parseDocument(d.Get("entities").(string))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse entities: %w", err)
+	}
+	config["entities"] = entities
+
+	return config, nil
+}
+
+func resourceSceneCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	sceneID := d.Get("scene_id").(string)
+	if sceneID == "" {
+		sceneID = id.UniqueId()
+	}
+
+	config, err := sceneConfigFromResourceData(d, sceneID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := c.SaveSceneConfigContext(ctx, sceneID, config); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to create scene: %w", err))
+	}
+
+	d.SetId(sceneID)
+
+	if err := c.ReloadScenesContext(ctx); err != nil {
+		return diag.FromErr(err)
+	}
+
+	diags := resourceSceneRead(ctx, d, m)
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, activateScene(ctx, c, d)...)
+}
+
+func resourceSceneRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	var diags diag.Diagnostics
+
+	config, err := c.GetSceneConfigContext(ctx, d.Id())
+	if err != nil {
+		if client.IsNotFound(err) {
+			// The scene was deleted outside of Terraform
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(fmt.Errorf("failed to read scene: %w", err))
+	}
+
+	name, _ := config["name"].(string)
+	icon, _ := config["icon"].(string)
+
+	d.Set("scene_id", d.Id())
+	d.Set("name", name)
+	d.Set("icon", icon)
+
+	entities, err := documentValue(d.Get("entities").(string), config["entities"])
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to format entities: %w", err))
+	}
+	d.Set("entities", entities)
+
+	// Scene entities are registered by the homeassistant platform with the
+	// scene ID as unique ID
+	entityID, err := c.FindEntityIDContext(ctx, "homeassistant", d.Id())
+	if err != nil && !client.IsNotFound(err) {
+		return diag.FromErr(fmt.Errorf("failed to resolve scene entity ID: %w", err))
+	}
+	d.Set("entity_id", entityID)
+
+	return diags
+}
+
+func resourceSceneUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	config, err := sceneConfigFromResourceData(d, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := c.SaveSceneConfigContext(ctx, d.Id(), config); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to update scene: %w", err))
+	}
+
+	if err := c.ReloadScenesContext(ctx); err != nil {
+		return diag.FromErr(err)
+	}
+
+	diags := resourceSceneRead(ctx, d, m)
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, activateScene(ctx, c, d)...)
+}
+
+func resourceSceneDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	var diags diag.Diagnostics
+
+	err := c.DeleteSceneConfigContext(ctx, d.Id())
+	if err != nil && !client.IsNotFound(err) {
+		return diag.FromErr(fmt.Errorf("failed to delete scene: %w", err))
+	}
+
+	if err := c.ReloadScenesContext(ctx); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// activateScene turns the scene on if activate_on_apply is set.
+func activateScene(ctx context.Context, c *client.Client, d *schema.ResourceData) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !d.Get("activate_on_apply").(bool) {
+		return diags
+	}
+
+	entityID := d.Get("entity_id").(string)
+	if entityID == "" {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Scene was not activated",
+			Detail:   fmt.Sprintf("The entity of scene %s is not registered yet, so it could not be activated.", d.Id()),
+		})
+	}
+
+	// Activating a scene again has no further effect
+	_, err := c.CallServiceContext(client.WithRetrySafe(ctx), "scene", "turn_on", map[string]interface{}{
+		"entity_id": entityID,
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to activate scene: %w", err))
+	}
+
+	return diags
+}
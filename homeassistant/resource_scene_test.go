@@ -0,0 +1,209 @@
+package homeassistant
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceScene_Schema(t *testing.T) {
+	s := resourceScene().Schema
+
+	// Test required fields
+	requiredFields := []string{"name", "entities"}
+	for _, field := range requiredFields {
+		if !s[field].Required {
+			t.Errorf("expected %s to be required", field)
+		}
+	}
+
+	// Test optional fields
+	optionalFields := []string{"scene_id", "icon", "activate_on_apply"}
+	for _, field := range optionalFields {
+		if s[field].Required {
+			t.Errorf("expected %s to be optional", field)
+		}
+	}
+
+	// Test computed fields
+	computedFields := []string{"scene_id", "entity_id"}
+	for _, field := range computedFields {
+		if !s[field].Computed {
+			t.Errorf("expected %s to be computed", field)
+		}
+	}
+}
+
+func TestResourceScene_HasTimeouts(t *testing.T) {
+	r := resourceScene()
+	if r.Timeouts == nil {
+		t.Fatal("expected resource to have timeouts")
+	}
+	if r.Timeouts.Create == nil || r.Timeouts.Update == nil || r.Timeouts.Delete == nil {
+		t.Error("expected create, update and delete timeouts to be set")
+	}
+}
+
+func TestResourceScene_EntitiesValidation(t *testing.T) {
+	validEntities := []string{
+		"light.tv_backlight:\n  state: on\n  brightness: 40\nmedia_player.tv: playing\n",
+		`{"light.tv_backlight": {"state": "on"}}`,
+	}
+	for _, entities := range validEntities {
+		if _, errs := validateSceneEntities(entities, "entities"); len(errs) > 0 {
+			t.Errorf("expected %q to be valid, got %v", entities, errs)
+		}
+	}
+
+	invalidEntities := []string{
+		"",
+		"{}",
+		"- light.tv_backlight\n",
+		`{"tv_backlight": {"state": "on"}}`,
+		"light.tv_backlight: [on\n",
+	}
+	for _, entities := range invalidEntities {
+		if _, errs := validateSceneEntities(entities, "entities"); len(errs) == 0 {
+			t.Errorf("expected %q to be invalid", entities)
+		}
+	}
+}
+
+func TestSceneConfigFromResourceData(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceScene().Schema, map[string]interface{}{
+		"name":     "Movie night",
+		"entities": "light.tv_backlight:\n  state: \"on\"\n  brightness: 40\n",
+	})
+
+	config, err := sceneConfigFromResourceData(d, "movie_night")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if config["id"] != "movie_night" || config["name"] != "Movie night" {
+		t.Errorf("unexpected id or name: %v", config)
+	}
+	if _, ok := config["icon"]; ok {
+		t.Errorf("expected unset icon to be omitted, got %v", config["icon"])
+	}
+
+	expectedEntities := map[string]interface{}{
+		"light.tv_backlight": map[string]interface{}{"state": "on", "brightness": float64(40)},
+	}
+	if !reflect.DeepEqual(config["entities"], expectedEntities) {
+		t.Errorf("expected entities %v, got %v", expectedEntities, config["entities"])
+	}
+}
+
+func TestActivateScene(t *testing.T) {
+	var activated []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/services/scene/turn_on" {
+			t.Errorf("expected path '/services/scene/turn_on', got %s", r.URL.Path)
+		}
+		var req map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&req)
+		activated = append(activated, req["entity_id"].(string))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte("[]"))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name     string
+		activate bool
+		entityID string
+		expected []string
+		warns    bool
+	}{
+		{"disabled", false, "scene.movie_night", nil, false},
+		{"enabled", true, "scene.movie_night", []string{"scene.movie_night"}, false},
+		{"entity not registered", true, "", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			activated = nil
+
+			d := resourceScene().TestResourceData()
+			d.SetId("movie_night")
+			d.Set("activate_on_apply", tt.activate)
+			d.Set("entity_id", tt.entityID)
+
+			diags := activateScene(context.Background(), testClient(server), d)
+			if diags.HasError() {
+				t.Fatalf("expected no error, got %v", diags)
+			}
+			if tt.warns && (len(diags) != 1 || diags[0].Severity != diag.Warning) {
+				t.Errorf("expected a warning, got %v", diags)
+			}
+			if !reflect.DeepEqual(activated, tt.expected) {
+				t.Errorf("expected activated scenes %v, got %v", tt.expected, activated)
+			}
+		})
+	}
+}
+
+// Acceptance tests - require a real Home Assistant instance
+
+func TestAccResourceScene_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSceneConfig_basic("Terraform test scene"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("homeassistant_scene.test", "name", "Terraform test scene"),
+					resource.TestCheckResourceAttrSet("homeassistant_scene.test", "scene_id"),
+					resource.TestCheckResourceAttrSet("homeassistant_scene.test", "entity_id"),
+				),
+			},
+			{
+				Config: testAccResourceSceneConfig_basic("Terraform test scene renamed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("homeassistant_scene.test", "name", "Terraform test scene renamed"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceScene_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSceneConfig_basic("Terraform test scene"),
+			},
+			{
+				ResourceName:      "homeassistant_scene.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Imported documents are formatted as YAML
+				ImportStateVerifyIgnore: []string{"entities", "activate_on_apply"},
+			},
+		},
+	})
+}
+
+func testAccResourceSceneConfig_basic(name string) string {
+	return `
+resource "homeassistant_scene" "test" {
+  name = "` + name + `"
+  icon = "mdi:movie-open"
+
+  entities = jsonencode({
+    "input_boolean.terraform_test" = "on"
+  })
+}
+`
+}
@@ -0,0 +1,194 @@
+package homeassistant
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// scheduleDays are the weekdays of a schedule, in the order Home Assistant
+// lists them.
+var scheduleDays = []string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}
+
+// scheduleRange is a time range during which a schedule is on.
+type scheduleRange struct {
+	From string
+	To   string
+}
+
+func resourceSchedule() *schema.Resource {
+	days := make(map[string]*schema.Schema, len(scheduleDays))
+	for _, day := range scheduleDays {
+		days[day] = scheduleDaySchema(day)
+	}
+
+	return helperCollection{
+		Domain:        "schedule",
+		Noun:          "schedule",
+		IconExample:   "mdi:calendar-clock",
+		Schema:        days,
+		Expand:        expandSchedule,
+		Flatten:       flattenSchedule,
+		CustomizeDiff: resourceScheduleCustomizeDiff,
+	}.resource()
+}
+
+func scheduleDaySchema(day string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: fmt.Sprintf("Time ranges during which the schedule is on every %s, in chronological order. Ranges must not overlap.", strings.ToUpper(day[:1])+day[1:]),
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"from": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateFunc:     validateScheduleTime,
+					DiffSuppressFunc: suppressEquivalentClockDuration,
+					Description:      "Start of the range as HH:MM:SS or HH:MM.",
+				},
+				"to": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateFunc:     validateScheduleTime,
+					DiffSuppressFunc: suppressEquivalentClockDuration,
+					Description:      "End of the range as HH:MM:SS or HH:MM. Use 24:00:00 for the end of the day.",
+				},
+			},
+		},
+	}
+}
+
+// parseScheduleTime parses a time of day between 00:00:00 and 24:00:00.
+func parseScheduleTime(s string) (time.Duration, error) {
+	t, err := parseClockDuration(s)
+	if err != nil {
+		return 0, err
+	}
+
+	if t > 24*time.Hour {
+		return 0, fmt.Errorf("%q is later than 24:00:00", s)
+	}
+
+	return t, nil
+}
+
+// validateScheduleTime checks that a value is a valid time of day.
+func validateScheduleTime(v interface{}, k string) ([]string, []error) {
+	s, ok := v.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected %s to be a string", k)}
+	}
+
+	if _, err := parseScheduleTime(s); err != nil {
+		return nil, []error{fmt.Errorf("invalid %s: %w", k, err)}
+	}
+
+	return nil, nil
+}
+
+// checkScheduleDay checks that every range of a day ends after it starts and
+// that the ranges are in chronological order without overlapping. A range may
+// start at the time the previous one ends.
+func checkScheduleDay(day string, ranges []scheduleRange) error {
+	var previousTo time.Duration
+
+	for i, r := range ranges {
+		from, err := parseScheduleTime(r.From)
+		if err != nil {
+			return fmt.Errorf("%s: %w", day, err)
+		}
+		to, err := parseScheduleTime(r.To)
+		if err != nil {
+			return fmt.Errorf("%s: %w", day, err)
+		}
+
+		if from >= to {
+			return fmt.Errorf("%s: range %s-%s must end after it starts", day, r.From, r.To)
+		}
+		if i > 0 && from < previousTo {
+			return fmt.Errorf("%s: range %s-%s overlaps the previous range or is not in chronological order", day, r.From, r.To)
+		}
+
+		previousTo = to
+	}
+
+	return nil
+}
+
+// expandScheduleDay converts the ranges of a day from the resource data.
+func expandScheduleDay(raw interface{}) []scheduleRange {
+	blocks, _ := raw.([]interface{})
+	ranges := make([]scheduleRange, 0, len(blocks))
+	for _, block := range blocks {
+		b, _ := block.(map[string]interface{})
+		from, _ := b["from"].(string)
+		to, _ := b["to"].(string)
+		ranges = append(ranges, scheduleRange{From: from, To: to})
+	}
+	return ranges
+}
+
+// expandSchedule adds the ranges of every day to a storage collection
+// payload. Days without ranges are sent as empty lists to clear them.
+func expandSchedule(d *schema.ResourceData, payload map[string]interface{}) {
+	for _, day := range scheduleDays {
+		ranges := make([]interface{}, 0)
+		for _, r := range expandScheduleDay(d.Get(day)) {
+			ranges = append(ranges, map[string]interface{}{
+				"from": r.From,
+				"to":   r.To,
+			})
+		}
+		payload[day] = ranges
+	}
+}
+
+// flattenSchedule sets the ranges of every day from a stored item.
+func flattenSchedule(d *schema.ResourceData, item map[string]interface{}) {
+	for _, day := range scheduleDays {
+		stored, _ := item[day].([]interface{})
+		ranges := make([]interface{}, 0, len(stored))
+		for _, raw := range stored {
+			r, _ := raw.(map[string]interface{})
+			from, _ := r["from"].(string)
+			to, _ := r["to"].(string)
+			ranges = append(ranges, map[string]interface{}{
+				"from": from,
+				"to":   to,
+			})
+		}
+		d.Set(day, ranges)
+	}
+}
+
+// resourceScheduleCustomizeDiff validates the ranges of each day at plan
+// time. Days with values that are not known yet are checked on a later plan.
+func resourceScheduleCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	for _, day := range scheduleDays {
+		if !d.NewValueKnown(day) {
+			continue
+		}
+
+		ranges := expandScheduleDay(d.Get(day))
+
+		known := true
+		for _, r := range ranges {
+			if r.From == "" || r.To == "" {
+				known = false
+			}
+		}
+		if !known {
+			continue
+		}
+
+		if err := checkScheduleDay(day, ranges); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
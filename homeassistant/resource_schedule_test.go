@@ -0,0 +1,164 @@
+package homeassistant
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceSchedule_Schema(t *testing.T) {
+	s := resourceSchedule().Schema
+
+	for _, day := range scheduleDays {
+		if s[day] == nil || !s[day].Optional {
+			t.Errorf("expected %s to be optional", day)
+		}
+	}
+
+	if !s["entity_id"].Computed {
+		t.Error("expected entity_id to be computed")
+	}
+
+	if resourceSchedule().CustomizeDiff == nil {
+		t.Error("expected the ranges to be validated at plan time")
+	}
+}
+
+func TestValidateScheduleTime(t *testing.T) {
+	for _, v := range []string{"00:00", "07:30", "07:30:15", "24:00:00"} {
+		if _, errs := validateScheduleTime(v, "from"); len(errs) > 0 {
+			t.Errorf("expected %q to be valid, got %v", v, errs)
+		}
+	}
+	for _, v := range []string{"7", "24:00:01", "25:00", "07:61"} {
+		if _, errs := validateScheduleTime(v, "from"); len(errs) == 0 {
+			t.Errorf("expected %q to be invalid", v)
+		}
+	}
+}
+
+func TestCheckScheduleDay(t *testing.T) {
+	tests := []struct {
+		name   string
+		ranges []scheduleRange
+		valid  bool
+	}{
+		{"empty", nil, true},
+		{"single range", []scheduleRange{{"06:00", "08:00"}}, true},
+		{"until midnight", []scheduleRange{{"22:00", "24:00:00"}}, true},
+		{"adjacent ranges", []scheduleRange{{"06:00", "08:00"}, {"08:00", "09:00"}}, true},
+		{"separate ranges", []scheduleRange{{"06:00", "08:00"}, {"17:00:00", "22:30"}}, true},
+		{"empty range", []scheduleRange{{"08:00", "08:00"}}, false},
+		{"reversed range", []scheduleRange{{"08:00", "06:00"}}, false},
+		{"overlapping ranges", []scheduleRange{{"06:00", "08:00"}, {"07:30", "09:00"}}, false},
+		{"contained range", []scheduleRange{{"06:00", "12:00"}, {"07:00", "08:00"}}, false},
+		{"out of order", []scheduleRange{{"17:00", "22:00"}, {"06:00", "08:00"}}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkScheduleDay("monday", tt.ranges)
+			if tt.valid && err != nil {
+				t.Errorf("expected no error, got %v", err)
+			}
+			if !tt.valid && err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestExpandSchedule(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceSchedule().Schema, map[string]interface{}{
+		"name": "Heating",
+		"monday": []interface{}{
+			map[string]interface{}{"from": "06:00", "to": "08:00"},
+			map[string]interface{}{"from": "17:00", "to": "22:00"},
+		},
+	})
+
+	payload := map[string]interface{}{}
+	expandSchedule(d, payload)
+
+	expected := []interface{}{
+		map[string]interface{}{"from": "06:00", "to": "08:00"},
+		map[string]interface{}{"from": "17:00", "to": "22:00"},
+	}
+	if !reflect.DeepEqual(payload["monday"], expected) {
+		t.Errorf("expected monday %v, got %v", expected, payload["monday"])
+	}
+	if tuesday, ok := payload["tuesday"].([]interface{}); !ok || len(tuesday) != 0 {
+		t.Errorf("expected tuesday to be cleared, got %v", payload["tuesday"])
+	}
+}
+
+func TestFlattenSchedule(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceSchedule().Schema, map[string]interface{}{})
+
+	flattenSchedule(d, map[string]interface{}{
+		"saturday": []interface{}{
+			map[string]interface{}{"from": "08:00:00", "to": "23:00:00"},
+		},
+	})
+
+	if d.Get("saturday.#") != 1 || d.Get("saturday.0.from") != "08:00:00" {
+		t.Errorf("unexpected saturday %v", d.Get("saturday"))
+	}
+	if d.Get("monday.#") != 0 {
+		t.Errorf("expected no monday ranges, got %v", d.Get("monday"))
+	}
+}
+
+// Acceptance tests - require a real Home Assistant instance
+
+func TestAccResourceSchedule_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceScheduleConfig_basic("22:00"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("homeassistant_schedule.test", "monday.#", "2"),
+					resource.TestCheckResourceAttrSet("homeassistant_schedule.test", "entity_id"),
+				),
+			},
+			{
+				Config: testAccResourceScheduleConfig_basic("23:30"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("homeassistant_schedule.test", "monday.1.to", "23:30:00"),
+				),
+			},
+			{
+				ResourceName:      "homeassistant_schedule.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceScheduleConfig_basic(eveningEnd string) string {
+	return `
+resource "homeassistant_schedule" "test" {
+  name = "Terraform Heating"
+
+  monday {
+    from = "06:00"
+    to   = "08:00"
+  }
+
+  monday {
+    from = "17:00"
+    to   = "` + eveningEnd + `"
+  }
+
+  saturday {
+    from = "08:00"
+    to   = "24:00:00"
+  }
+}
+`
+}
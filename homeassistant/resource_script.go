@@ -0,0 +1,241 @@
+package homeassistant
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/dawwestk/terraform-provider-homeassistant/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceScript() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceScriptCreate,
+		ReadContext:   resourceScriptRead,
+		UpdateContext: resourceScriptUpdate,
+		DeleteContext: resourceScriptDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceScriptImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"object_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[a-z0-9_]+$`),
+					"must only contain lowercase letters, digits and underscores",
+				),
+				Description: "Object ID of the script, used as its entity ID (e.g., goodnight for script.goodnight).",
+			},
+			"alias": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the script.",
+			},
+			"icon": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "MDI icon for the script (e.g., mdi:weather-night).",
+			},
+			"mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "single",
+				ValidateFunc: validation.StringInSlice([]string{"single", "restart", "queued", "parallel"}, false),
+				Description:  "What happens when the script is started while still running: single, restart, queued or parallel. Defaults to single.",
+			},
+			"max": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Maximum number of runs that can be queued or run in parallel. Only used with the queued and parallel modes.",
+			},
+			"fields": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateDocument,
+				DiffSuppressFunc: suppressEquivalentDocument,
+				Description:      "Input fields accepted by the script, as a YAML or JSON object.",
+			},
+			"sequence": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateDocument,
+				DiffSuppressFunc: suppressEquivalentDocument,
+				Description:      "Actions run by the script, as a YAML or JSON list.",
+			},
+			// Computed attributes
+			"entity_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The entity ID of the script (e.g., script.goodnight).",
+			},
+		},
+	}
+}
+
+// scriptConfigFromResourceData builds the script configuration from the resource data.
+func scriptConfigFromResourceData(d *schema.ResourceData) (map[string]interface{}, error) {
+	config := map[string]interface{}{
+		"alias": d.Get("alias").(string),
+		"mode":  d.Get("mode").(string),
+	}
+
+	if icon := d.Get("icon").(string); icon != "" {
+		config["icon"] = icon
+	}
+	if max := d.Get("max").(int); max > 0 {
+		config["max"] = max
+	}
+
+	fields, err := parseDocument(d.Get("fields").(string))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse fields: %w", err)
+	}
+	if fields != nil {
+		config["fields"] = fields
+	}
+
+	sequence, err := parseDocument(d.Get("sequence").(string))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse sequence: %w", err)
+	}
+	if sequence == nil {
+		sequence = []interface{}{}
+	}
+	config["sequence"] = sequence
+
+	return config, nil
+}
+
+func resourceScriptCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	objectID := d.Get("object_id").(string)
+
+	config, err := scriptConfigFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := c.SaveScriptConfigContext(ctx, objectID, config); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to create script: %w", err))
+	}
+
+	d.SetId(objectID)
+
+	if err := c.ReloadScriptsContext(ctx); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceScriptRead(ctx, d, m)
+}
+
+func resourceScriptRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	var diags diag.Diagnostics
+
+	config, err := c.GetScriptConfigContext(ctx, d.Id())
+	if err != nil {
+		if client.IsNotFound(err) {
+			// The script was deleted outside of Terraform
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(fmt.Errorf("failed to read script: %w", err))
+	}
+
+	alias, _ := config["alias"].(string)
+	icon, _ := config["icon"].(string)
+	mode, _ := config["mode"].(string)
+	if mode == "" {
+		mode = "single"
+	}
+
+	d.Set("object_id", d.Id())
+	d.Set("alias", alias)
+	d.Set("icon", icon)
+	d.Set("mode", mode)
+	d.Set("entity_id", "script."+d.Id())
+
+	if max, ok := config["max"].(float64); ok {
+		d.Set("max", int(max))
+	} else {
+		d.Set("max", 0)
+	}
+
+	fields, err := documentValue(d.Get("fields").(string), config["fields"])
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to format fields: %w", err))
+	}
+	d.Set("fields", fields)
+
+	sequence, err := documentValue(d.Get("sequence").(string), config["sequence"])
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to format sequence: %w", err))
+	}
+	d.Set("sequence", sequence)
+
+	return diags
+}
+
+func resourceScriptUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	config, err := scriptConfigFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := c.SaveScriptConfigContext(ctx, d.Id(), config); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to update script: %w", err))
+	}
+
+	if err := c.ReloadScriptsContext(ctx); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceScriptRead(ctx, d, m)
+}
+
+func resourceScriptDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	var diags diag.Diagnostics
+
+	err := c.DeleteScriptConfigContext(ctx, d.Id())
+	if err != nil && !client.IsNotFound(err) {
+		return diag.FromErr(fmt.Errorf("failed to delete script: %w", err))
+	}
+
+	if err := c.ReloadScriptsContext(ctx); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// resourceScriptImport accepts either the object ID or the entity ID of a
+// script (e.g., goodnight or script.goodnight).
+func resourceScriptImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	d.SetId(strings.TrimPrefix(d.Id(), "script."))
+
+	return []*schema.ResourceData{d}, nil
+}
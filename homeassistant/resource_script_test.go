@@ -0,0 +1,182 @@
+package homeassistant
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceScript_Schema(t *testing.T) {
+	s := resourceScript().Schema
+
+	// Test required fields
+	requiredFields := []string{"object_id", "sequence"}
+	for _, field := range requiredFields {
+		if !s[field].Required {
+			t.Errorf("expected %s to be required", field)
+		}
+	}
+
+	// Test optional fields
+	optionalFields := []string{"alias", "icon", "mode", "max", "fields"}
+	for _, field := range optionalFields {
+		if s[field].Required {
+			t.Errorf("expected %s to be optional", field)
+		}
+	}
+
+	if !s["entity_id"].Computed {
+		t.Error("expected entity_id to be computed")
+	}
+	if !s["object_id"].ForceNew {
+		t.Error("expected object_id to force a new resource")
+	}
+}
+
+func TestResourceScript_HasTimeouts(t *testing.T) {
+	r := resourceScript()
+	if r.Timeouts == nil {
+		t.Fatal("expected resource to have timeouts")
+	}
+	if r.Timeouts.Create == nil || r.Timeouts.Update == nil || r.Timeouts.Delete == nil {
+		t.Error("expected create, update and delete timeouts to be set")
+	}
+}
+
+func TestResourceScript_ObjectIDValidation(t *testing.T) {
+	s := resourceScript().Schema["object_id"]
+
+	validIDs := []string{"goodnight", "morning_routine_2"}
+	for _, id := range validIDs {
+		_, errs := s.ValidateFunc(id, "object_id")
+		if len(errs) > 0 {
+			t.Errorf("expected object_id %s to be valid, got %v", id, errs)
+		}
+	}
+
+	invalidIDs := []string{"script.goodnight", "Good Night", "good-night", ""}
+	for _, id := range invalidIDs {
+		_, errs := s.ValidateFunc(id, "object_id")
+		if len(errs) == 0 {
+			t.Errorf("expected object_id %q to be invalid", id)
+		}
+	}
+}
+
+func TestResourceScript_ImportByEntityID(t *testing.T) {
+	d := resourceScript().TestResourceData()
+	d.SetId("script.goodnight")
+
+	result, err := resourceScriptImport(context.Background(), d, nil)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result[0].Id() != "goodnight" {
+		t.Errorf("expected ID 'goodnight', got %s", result[0].Id())
+	}
+}
+
+func TestScriptConfigFromResourceData(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceScript().Schema, map[string]interface{}{
+		"object_id": "goodnight",
+		"alias":     "Goodnight",
+		"fields":    "brightness:\n  description: Brightness of the hallway light\n  example: 10\n",
+		"sequence":  `[{"action": "light.turn_off", "target": {"area_id": "bedroom"}}]`,
+	})
+
+	config, err := scriptConfigFromResourceData(d)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if config["alias"] != "Goodnight" || config["mode"] != "single" {
+		t.Errorf("unexpected alias or mode: %v", config)
+	}
+	if _, ok := config["icon"]; ok {
+		t.Errorf("expected unset icon to be omitted, got %v", config["icon"])
+	}
+
+	expectedFields := map[string]interface{}{
+		"brightness": map[string]interface{}{
+			"description": "Brightness of the hallway light",
+			"example":     float64(10),
+		},
+	}
+	if !reflect.DeepEqual(config["fields"], expectedFields) {
+		t.Errorf("expected fields %v, got %v", expectedFields, config["fields"])
+	}
+
+	sequence, ok := config["sequence"].([]interface{})
+	if !ok || len(sequence) != 1 {
+		t.Errorf("expected 1 step in sequence, got %v", config["sequence"])
+	}
+}
+
+// Acceptance tests - require a real Home Assistant instance
+
+func TestAccResourceScript_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceScriptConfig_basic("Terraform test script"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("homeassistant_script.test", "alias", "Terraform test script"),
+					resource.TestCheckResourceAttr("homeassistant_script.test", "entity_id", "script.terraform_test_script"),
+				),
+			},
+			{
+				Config: testAccResourceScriptConfig_basic("Terraform test script renamed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("homeassistant_script.test", "alias", "Terraform test script renamed"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceScript_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceScriptConfig_basic("Terraform test script"),
+			},
+			{
+				ResourceName:      "homeassistant_script.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Imported documents are formatted as YAML
+				ImportStateVerifyIgnore: []string{"fields", "sequence"},
+			},
+		},
+	})
+}
+
+func testAccResourceScriptConfig_basic(alias string) string {
+	return `
+resource "homeassistant_script" "test" {
+  object_id = "terraform_test_script"
+  alias     = "` + alias + `"
+  icon      = "mdi:script-text"
+
+  fields = jsonencode({
+    message = {
+      description = "Message to show"
+      example     = "Hello"
+    }
+  })
+
+  sequence = <<-EOT
+    - action: persistent_notification.create
+      data:
+        message: "{{ message }}"
+  EOT
+}
+`
+}
@@ -0,0 +1,160 @@
+package homeassistant
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dawwestk/terraform-provider-homeassistant/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceSwitch() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSwitchCreate,
+		ReadContext:   resourceSwitchRead,
+		UpdateContext: resourceSwitchUpdate,
+		DeleteContext: resourceSwitchDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"entity_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The entity ID of the switch (e.g., switch.coffee_machine).",
+			},
+			"state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"on", "off"}, false),
+				Description:  "Desired state of the switch: 'on' or 'off'. If not specified, reads current state from Home Assistant.",
+			},
+			"wait_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 600),
+				Description:  "Seconds to wait for the switch to report the desired state after a change. Defaults to 10. Set to 0 to skip waiting.",
+			},
+		},
+	}
+}
+
+func resourceSwitchCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	entityID := d.Get("entity_id").(string)
+	state := d.Get("state").(string)
+
+	// If state is not specified, default to "on"
+	if state == "" {
+		state = "on"
+	}
+
+	if err := setSwitchState(ctx, c, entityID, state); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set switch state: %w", err))
+	}
+
+	d.SetId(entityID)
+
+	// Wait for the switch to report the new state before reading it back
+	diags := waitForState(ctx, c, entityID, desiredState{State: state}, stateWaitTimeout(d))
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, resourceSwitchRead(ctx, d, m)...)
+}
+
+func resourceSwitchRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	var diags diag.Diagnostics
+
+	entityID := d.Id()
+
+	haState, err := c.GetStateContext(ctx, entityID)
+	if err != nil {
+		if client.IsNotFound(err) {
+			// The switch no longer exists in Home Assistant
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(fmt.Errorf("failed to read switch state: %w", err))
+	}
+
+	// Set entity_id if not already set (happens during import)
+	if d.Get("entity_id").(string) == "" {
+		d.Set("entity_id", entityID)
+	}
+
+	d.Set("state", haState.State)
+
+	return diags
+}
+
+func resourceSwitchUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	state := d.Get("state").(string)
+
+	// If state is not specified, default to "on"
+	if state == "" {
+		state = "on"
+	}
+
+	if err := setSwitchState(ctx, c, d.Id(), state); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to update switch state: %w", err))
+	}
+
+	// Wait for the switch to report the new state before reading it back
+	diags := waitForState(ctx, c, d.Id(), desiredState{State: state}, stateWaitTimeout(d))
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, resourceSwitchRead(ctx, d, m)...)
+}
+
+func resourceSwitchDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	var diags diag.Diagnostics
+
+	// Turn off the switch when the resource is deleted
+	if err := setSwitchState(ctx, c, d.Get("entity_id").(string), "off"); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to turn off switch: %w", err))
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// setSwitchState calls switch.turn_on or switch.turn_off for the entity.
+func setSwitchState(ctx context.Context, c *client.Client, entityID, state string) error {
+	service := "turn_off"
+	if state == "on" {
+		service = "turn_on"
+	}
+
+	serviceData := map[string]interface{}{
+		"entity_id": entityID,
+	}
+
+	// Turning a switch on or off sets an absolute state, so it is safe to retry
+	_, err := c.CallServiceContext(client.WithRetrySafe(ctx), "switch", service, serviceData)
+	return err
+}
@@ -0,0 +1,179 @@
+package homeassistant
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// getTestSwitchEntityID returns the switch entity ID to use for tests.
+// Set HA_TEST_SWITCH_ENTITY env var to specify a real switch entity.
+func getTestSwitchEntityID() string {
+	if v := os.Getenv("HA_TEST_SWITCH_ENTITY"); v != "" {
+		return v
+	}
+	return "switch.test_switch"
+}
+
+func TestResourceSwitch_Schema(t *testing.T) {
+	s := resourceSwitch().Schema
+
+	if !s["entity_id"].Required {
+		t.Error("expected entity_id to be required")
+	}
+	if !s["entity_id"].ForceNew {
+		t.Error("expected entity_id to force a new resource")
+	}
+
+	optionalFields := []string{"state", "wait_timeout"}
+	for _, field := range optionalFields {
+		if s[field].Required {
+			t.Errorf("expected %s to be optional", field)
+		}
+	}
+}
+
+func TestResourceSwitch_HasTimeouts(t *testing.T) {
+	r := resourceSwitch()
+	if r.Timeouts == nil {
+		t.Fatal("expected resource to have timeouts")
+	}
+	if r.Timeouts.Create == nil || r.Timeouts.Update == nil || r.Timeouts.Delete == nil {
+		t.Error("expected create, update and delete timeouts to be set")
+	}
+}
+
+func TestResourceSwitch_HasImporter(t *testing.T) {
+	r := resourceSwitch()
+	if r.Importer == nil {
+		t.Error("expected resource to have an importer")
+	}
+}
+
+func TestResourceSwitch_StateValidation(t *testing.T) {
+	s := resourceSwitch().Schema["state"]
+
+	for _, v := range []string{"on", "off"} {
+		_, errs := s.ValidateFunc(v, "state")
+		if len(errs) > 0 {
+			t.Errorf("expected '%s' to be valid, got errors: %v", v, errs)
+		}
+	}
+
+	_, errs := s.ValidateFunc("toggle", "state")
+	if len(errs) == 0 {
+		t.Error("expected 'toggle' to fail validation")
+	}
+}
+
+func TestResourceSwitch_WaitTimeoutAbsentFromStatePlansNoChanges(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "switch.coffee_machine",
+		Attributes: map[string]string{
+			"id":        "switch.coffee_machine",
+			"entity_id": "switch.coffee_machine",
+			"state":     "on",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"entity_id": "switch.coffee_machine",
+		"state":     "on",
+	})
+
+	diff, err := resourceSwitch().Diff(context.Background(), state, config, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff != nil && !diff.Empty() {
+		t.Errorf("expected no changes, got %v", diff.Attributes)
+	}
+}
+
+func TestResourceSwitchRead_RemovesMissingSwitch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"message": "Entity not found."}`))
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceSwitch().Schema, map[string]interface{}{})
+	d.SetId("switch.gone")
+
+	diags := resourceSwitchRead(context.Background(), d, testClient(server))
+	if diags.HasError() {
+		t.Fatalf("expected no error, got %v", diags)
+	}
+	if d.Id() != "" {
+		t.Errorf("expected switch to be removed from state, ID is %q", d.Id())
+	}
+}
+
+// Acceptance tests - require a real Home Assistant instance
+// Run with: TF_ACC=1 HA_TEST_SWITCH_ENTITY=switch.your_switch go test -v ./homeassistant/
+
+func testAccSwitchPreCheck(t *testing.T) {
+	testAccPreCheck(t)
+	if v := os.Getenv("HA_TEST_SWITCH_ENTITY"); v == "" {
+		t.Skip("HA_TEST_SWITCH_ENTITY must be set for switch acceptance tests")
+	}
+}
+
+func TestAccResourceSwitch_basic(t *testing.T) {
+	entityID := getTestSwitchEntityID()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccSwitchPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSwitchConfig(entityID, "on"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("homeassistant_switch.test", "entity_id", entityID),
+					resource.TestCheckResourceAttr("homeassistant_switch.test", "state", "on"),
+				),
+			},
+			{
+				Config: testAccResourceSwitchConfig(entityID, "off"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("homeassistant_switch.test", "state", "off"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceSwitch_import(t *testing.T) {
+	entityID := getTestSwitchEntityID()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccSwitchPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSwitchConfig(entityID, "on"),
+			},
+			{
+				ResourceName:            "homeassistant_switch.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_timeout"},
+			},
+		},
+	})
+}
+
+func testAccResourceSwitchConfig(entityID, state string) string {
+	return fmt.Sprintf(`
+resource "homeassistant_switch" "test" {
+  entity_id = %q
+  state     = %q
+}
+`, entityID, state)
+}
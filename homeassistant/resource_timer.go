@@ -0,0 +1,103 @@
+package homeassistant
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceTimer() *schema.Resource {
+	return helperCollection{
+		Domain:      "timer",
+		Noun:        "timer",
+		IconExample: "mdi:timer-outline",
+		Schema: map[string]*schema.Schema{
+			"duration": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "0:00:00",
+				ValidateFunc:     validateTimerDuration,
+				DiffSuppressFunc: suppressEquivalentClockDuration,
+				Description:      "Default duration of the timer as HH:MM:SS or HH:MM. Defaults to 0:00:00.",
+			},
+			"restore": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether an active or paused timer is restored after a restart. Defaults to false.",
+			},
+		},
+		Expand:  expandTimer,
+		Flatten: flattenTimer,
+	}.resource()
+}
+
+// expandTimer adds the timer attributes to a storage collection payload.
+func expandTimer(d *schema.ResourceData, payload map[string]interface{}) {
+	payload["duration"] = d.Get("duration").(string)
+	payload["restore"] = d.Get("restore").(bool)
+}
+
+// flattenTimer sets the timer attributes from a stored item. Home Assistant
+// stores the duration as H:MM:SS.
+func flattenTimer(d *schema.ResourceData, item map[string]interface{}) {
+	duration, _ := item["duration"].(string)
+	restore, _ := item["restore"].(bool)
+	d.Set("duration", duration)
+	d.Set("restore", restore)
+}
+
+// parseClockDuration parses a duration given as HH:MM:SS or HH:MM, the
+// formats Home Assistant accepts for timers and schedules.
+func parseClockDuration(s string) (time.Duration, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, fmt.Errorf("%q is not formatted as HH:MM:SS or HH:MM", s)
+	}
+
+	units := []time.Duration{time.Hour, time.Minute, time.Second}
+
+	var duration time.Duration
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 || (i > 0 && n > 59) {
+			return 0, fmt.Errorf("%q is not formatted as HH:MM:SS or HH:MM", s)
+		}
+		duration += time.Duration(n) * units[i]
+	}
+
+	return duration, nil
+}
+
+// validateTimerDuration checks that a value is a valid timer duration.
+func validateTimerDuration(v interface{}, k string) ([]string, []error) {
+	s, ok := v.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected %s to be a string", k)}
+	}
+
+	if _, err := parseClockDuration(s); err != nil {
+		return nil, []error{fmt.Errorf("invalid %s: %w", k, err)}
+	}
+
+	return nil, nil
+}
+
+// suppressEquivalentClockDuration ignores differences in how a duration or
+// time of day is written, such as 00:05:00 and 0:05:00.
+func suppressEquivalentClockDuration(k, old, new string, d *schema.ResourceData) bool {
+	a, err := parseClockDuration(old)
+	if err != nil {
+		return false
+	}
+
+	b, err := parseClockDuration(new)
+	if err != nil {
+		return false
+	}
+
+	return a == b
+}
@@ -0,0 +1,107 @@
+package homeassistant
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestResourceTimer_Schema(t *testing.T) {
+	s := resourceTimer().Schema
+
+	// Test optional fields
+	optionalFields := []string{"duration", "restore", "icon"}
+	for _, field := range optionalFields {
+		if !s[field].Optional {
+			t.Errorf("expected %s to be optional", field)
+		}
+	}
+
+	if !s["entity_id"].Computed {
+		t.Error("expected entity_id to be computed")
+	}
+}
+
+func TestParseClockDuration(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected time.Duration
+		valid    bool
+	}{
+		{"0:05:00", 5 * time.Minute, true},
+		{"00:05:00", 5 * time.Minute, true},
+		{"01:30", 90 * time.Minute, true},
+		{"25:00:10", 25*time.Hour + 10*time.Second, true},
+		{"5", 0, false},
+		{"0:60:00", 0, false},
+		{"0:05:xx", 0, false},
+		{"1:2:3:4", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := parseClockDuration(tt.input)
+			if tt.valid && err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if !tt.valid && err == nil {
+				t.Fatal("expected an error")
+			}
+			if got != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestSuppressEquivalentClockDuration(t *testing.T) {
+	if !suppressEquivalentClockDuration("duration", "0:05:00", "00:05:00", nil) {
+		t.Error("expected equivalent durations to be suppressed")
+	}
+	if !suppressEquivalentClockDuration("duration", "1:30:00", "01:30", nil) {
+		t.Error("expected HH:MM to match HH:MM:SS")
+	}
+	if suppressEquivalentClockDuration("duration", "0:05:00", "0:10:00", nil) {
+		t.Error("expected different durations not to be suppressed")
+	}
+}
+
+// Acceptance tests - require a real Home Assistant instance
+
+func TestAccResourceTimer_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceTimerConfig_basic("00:05:00"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("homeassistant_timer.test", "name", "Terraform Laundry"),
+					resource.TestCheckResourceAttrSet("homeassistant_timer.test", "entity_id"),
+				),
+			},
+			{
+				Config: testAccResourceTimerConfig_basic("01:30:00"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("homeassistant_timer.test", "duration", "1:30:00"),
+				),
+			},
+			{
+				ResourceName:      "homeassistant_timer.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceTimerConfig_basic(duration string) string {
+	return `
+resource "homeassistant_timer" "test" {
+  name     = "Terraform Laundry"
+  duration = "` + duration + `"
+  restore  = true
+}
+`
+}
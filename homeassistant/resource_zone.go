@@ -3,8 +3,7 @@ package homeassistant
 import (
 	"context"
 	"fmt"
-	"regexp"
-	"strings"
+	"time"
 
 	"github.com/dawwestk/terraform-provider-homeassistant/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -20,7 +19,13 @@ func resourceZone() *schema.Resource {
 		DeleteContext: resourceZoneDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importHelper("zone"),
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -57,14 +62,14 @@ func resourceZone() *schema.Resource {
 			"icon": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Default:     "mdi:map-marker",
-				Description: "MDI icon for the zone (e.g., mdi:home). Defaults to mdi:map-marker.",
+				Computed:    true,
+				Description: "MDI icon for the zone (e.g., mdi:home). Home Assistant keeps the current icon when removed from the configuration.",
 			},
 			// Computed attributes
 			"entity_id": {
 				Type:        schema.TypeString,
 				Computed:    true,
-				Description: "The entity ID of the zone (e.g., zone.home).",
+				Description: "The entity ID of the zone (e.g., zone.office).",
 			},
 			"state": {
 				Type:        schema.TypeString,
@@ -74,53 +79,41 @@ func resourceZone() *schema.Resource {
 			"editable": {
 				Type:        schema.TypeBool,
 				Computed:    true,
-				Description: "Whether the zone is editable.",
+				Description: "Whether the zone is editable. Always true for zones managed by Terraform.",
 			},
 		},
 	}
 }
 
-// slugify converts a name to a slug suitable for entity_id
-func slugify(name string) string {
-	// Convert to lowercase
-	slug := strings.ToLower(name)
-	// Replace spaces and special chars with underscores
-	reg := regexp.MustCompile(`[^a-z0-9]+`)
-	slug = reg.ReplaceAllString(slug, "_")
-	// Trim underscores from ends
-	slug = strings.Trim(slug, "_")
-	return slug
+// zoneFromResourceData builds a zone storage collection item from the resource data.
+func zoneFromResourceData(d *schema.ResourceData) client.Zone {
+	return client.Zone{
+		Name:      d.Get("name").(string),
+		Latitude:  d.Get("latitude").(float64),
+		Longitude: d.Get("longitude").(float64),
+		Radius:    d.Get("radius").(float64),
+		Passive:   d.Get("passive").(bool),
+		Icon:      d.Get("icon").(string),
+	}
 }
 
 func resourceZoneCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*client.Client)
 
-	name := d.Get("name").(string)
-	entityID := fmt.Sprintf("zone.%s", slugify(name))
-
-	// Build state update request
-	attributes := map[string]interface{}{
-		"friendly_name": name,
-		"latitude":      d.Get("latitude").(float64),
-		"longitude":     d.Get("longitude").(float64),
-		"radius":        d.Get("radius").(float64),
-		"passive":       d.Get("passive").(bool),
-		"icon":          d.Get("icon").(string),
-		"editable":      true,
-		"persons":       []string{},
+	zone, err := c.CreateZoneContext(ctx, zoneFromResourceData(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to create zone: %w", err))
 	}
 
-	stateReq := client.StateUpdateRequest{
-		State:      "0",
-		Attributes: attributes,
-	}
+	d.SetId(zone.ID)
 
-	_, err := c.SetState(entityID, stateReq)
+	// The entity ID may differ from the zone id after a collision or a
+	// rename, so resolve it from the entity registry.
+	entityID, err := helperEntityID(ctx, c, "zone", zone.ID)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to create zone: %w", err))
+		return diag.FromErr(err)
 	}
-
-	d.SetId(entityID)
+	d.Set("entity_id", entityID)
 
 	return resourceZoneRead(ctx, d, m)
 }
@@ -130,59 +123,41 @@ func resourceZoneRead(ctx context.Context, d *schema.ResourceData, m interface{}
 
 	var diags diag.Diagnostics
 
-	entityID := d.Id()
-
-	state, err := c.GetState(entityID)
+	zone, err := c.GetZoneContext(ctx, d.Id())
 	if err != nil {
-		// If the zone doesn't exist, remove it from state
-		d.SetId("")
-		return diags
-	}
-
-	d.Set("entity_id", entityID)
-	d.Set("state", state.State)
-
-	// Extract attributes
-	if friendlyName, ok := state.Attributes["friendly_name"]; ok {
-		if fn, ok := friendlyName.(string); ok {
-			d.Set("name", fn)
-		}
-	}
-
-	if latitude, ok := state.Attributes["latitude"]; ok {
-		if lat, ok := latitude.(float64); ok {
-			d.Set("latitude", lat)
-		}
-	}
-
-	if longitude, ok := state.Attributes["longitude"]; ok {
-		if lon, ok := longitude.(float64); ok {
-			d.Set("longitude", lon)
-		}
-	}
-
-	if radius, ok := state.Attributes["radius"]; ok {
-		if r, ok := radius.(float64); ok {
-			d.Set("radius", r)
+		if client.IsNotFound(err) {
+			// The zone was deleted outside of Terraform
+			d.SetId("")
+			return diags
 		}
-	}
-
-	if passive, ok := state.Attributes["passive"]; ok {
-		if p, ok := passive.(bool); ok {
-			d.Set("passive", p)
+		return diag.FromErr(fmt.Errorf("failed to read zone: %w", err))
+	}
+
+	d.Set("name", zone.Name)
+	d.Set("latitude", zone.Latitude)
+	d.Set("longitude", zone.Longitude)
+	d.Set("radius", zone.Radius)
+	d.Set("passive", zone.Passive)
+	d.Set("icon", zone.Icon)
+	d.Set("editable", true)
+
+	entityID := d.Get("entity_id").(string)
+	if entityID == "" {
+		entityID, err = helperEntityID(ctx, c, "zone", zone.ID)
+		if err != nil {
+			return diag.FromErr(err)
 		}
+		d.Set("entity_id", entityID)
 	}
 
-	if icon, ok := state.Attributes["icon"]; ok {
-		if i, ok := icon.(string); ok {
-			d.Set("icon", i)
-		}
+	// The state (number of persons in the zone) lives on the entity,
+	// which may not have been added yet right after creation
+	state, err := c.GetStateContext(ctx, entityID)
+	if err != nil && !client.IsNotFound(err) {
+		return diag.FromErr(fmt.Errorf("failed to read zone state: %w", err))
 	}
-
-	if editable, ok := state.Attributes["editable"]; ok {
-		if e, ok := editable.(bool); ok {
-			d.Set("editable", e)
-		}
+	if err == nil {
+		d.Set("state", state.State)
 	}
 
 	return diags
@@ -191,39 +166,7 @@ func resourceZoneRead(ctx context.Context, d *schema.ResourceData, m interface{}
 func resourceZoneUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*client.Client)
 
-	entityID := d.Id()
-	name := d.Get("name").(string)
-
-	// Build state update request with current values
-	attributes := map[string]interface{}{
-		"friendly_name": name,
-		"latitude":      d.Get("latitude").(float64),
-		"longitude":     d.Get("longitude").(float64),
-		"radius":        d.Get("radius").(float64),
-		"passive":       d.Get("passive").(bool),
-		"icon":          d.Get("icon").(string),
-		"editable":      true,
-	}
-
-	// Preserve existing persons list
-	currentState, err := c.GetState(entityID)
-	if err == nil {
-		if persons, ok := currentState.Attributes["persons"]; ok {
-			attributes["persons"] = persons
-		}
-	}
-
-	stateReq := client.StateUpdateRequest{
-		State:      d.Get("state").(string),
-		Attributes: attributes,
-	}
-
-	// If state is empty, default to "0"
-	if stateReq.State == "" {
-		stateReq.State = "0"
-	}
-
-	_, err = c.SetState(entityID, stateReq)
+	_, err := c.UpdateZoneContext(ctx, d.Id(), zoneFromResourceData(d))
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to update zone: %w", err))
 	}
@@ -236,18 +179,8 @@ func resourceZoneDelete(ctx context.Context, d *schema.ResourceData, m interface
 
 	var diags diag.Diagnostics
 
-	entityID := d.Id()
-
-	// Set state to "unavailable" to effectively remove the zone
-	// Note: HA doesn't have a direct delete API for states, 
-	// but setting to unavailable marks it as inactive
-	stateReq := client.StateUpdateRequest{
-		State:      "unavailable",
-		Attributes: map[string]interface{}{},
-	}
-
-	_, err := c.SetState(entityID, stateReq)
-	if err != nil {
+	err := c.DeleteZoneContext(ctx, d.Id())
+	if err != nil && !client.IsNotFound(err) {
 		return diag.FromErr(fmt.Errorf("failed to delete zone: %w", err))
 	}
 
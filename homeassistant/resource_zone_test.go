@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestResourceZone_Schema(t *testing.T) {
@@ -35,6 +36,16 @@ func TestResourceZone_Schema(t *testing.T) {
 	}
 }
 
+func TestResourceZone_HasTimeouts(t *testing.T) {
+	r := resourceZone()
+	if r.Timeouts == nil {
+		t.Fatal("expected resource to have timeouts")
+	}
+	if r.Timeouts.Create == nil || r.Timeouts.Update == nil || r.Timeouts.Delete == nil {
+		t.Error("expected create, update and delete timeouts to be set")
+	}
+}
+
 func TestResourceZone_HasImporter(t *testing.T) {
 	r := resourceZone()
 	if r.Importer == nil {
@@ -98,34 +109,13 @@ func TestResourceZone_PassiveDefault(t *testing.T) {
 	}
 }
 
-func TestResourceZone_IconDefault(t *testing.T) {
+func TestResourceZone_IconComputed(t *testing.T) {
 	s := resourceZone().Schema["icon"]
 
-	if s.Default != "mdi:map-marker" {
-		t.Errorf("expected icon default to be 'mdi:map-marker', got %v", s.Default)
-	}
-}
-
-func TestSlugify(t *testing.T) {
-	tests := []struct {
-		input    string
-		expected string
-	}{
-		{"Home", "home"},
-		{"Living Room", "living_room"},
-		{"Office 2", "office_2"},
-		{"Test-Zone", "test_zone"},
-		{"  Spaces  ", "spaces"},
-		{"Special!@#Chars", "special_chars"},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.input, func(t *testing.T) {
-			result := slugify(tt.input)
-			if result != tt.expected {
-				t.Errorf("slugify(%q) = %q, expected %q", tt.input, result, tt.expected)
-			}
-		})
+	// Zones without an icon are read back with an empty one, so a default
+	// would plan a change for every imported zone
+	if s.Default != nil || !s.Computed {
+		t.Errorf("expected icon to be computed without a default, got default %v", s.Default)
 	}
 }
 
@@ -207,6 +197,30 @@ func TestAccResourceZone_import(t *testing.T) {
 	})
 }
 
+func TestAccResourceZone_importByEntityID(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceZoneConfig_basic(),
+			},
+			{
+				ResourceName: "homeassistant_zone.test",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["homeassistant_zone.test"]
+					if !ok {
+						return "", fmt.Errorf("resource homeassistant_zone.test not found")
+					}
+					return rs.Primary.Attributes["entity_id"], nil
+				},
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccResourceZoneConfig_basic() string {
 	return `
 resource "homeassistant_zone" "test" {
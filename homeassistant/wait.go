@@ -0,0 +1,192 @@
+package homeassistant
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dawwestk/terraform-provider-homeassistant/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	// stateWaitIntervalMin and stateWaitIntervalMax bound the delay between polls
+	// while waiting for an entity to report its new state.
+	stateWaitIntervalMin = 100 * time.Millisecond
+	stateWaitIntervalMax = time.Second
+
+	// defaultStateWaitTimeout is how long resources wait for an entity to
+	// converge unless configured otherwise.
+	defaultStateWaitTimeout = 10
+)
+
+// stateWaitTimeout returns the wait_timeout of a resource as a duration. The
+// attribute has no schema default, so that resources in existing or imported
+// state do not plan a change, and defaultStateWaitTimeout applies when unset.
+func stateWaitTimeout(d *schema.ResourceData) time.Duration {
+	if !isConfigured(d, "wait_timeout") {
+		return defaultStateWaitTimeout * time.Second
+	}
+	return time.Duration(d.Get("wait_timeout").(int)) * time.Second
+}
+
+// desiredState describes the state and attributes an entity is expected to
+// report after a service call. An empty State or a nil Attributes map means
+// that part is not checked.
+type desiredState struct {
+	State      string
+	Attributes map[string]interface{}
+}
+
+// waitForState polls the entity until it reports the desired state and
+// attributes, or until timeout expires. If the entity does not converge in
+// time, a warning diagnostic lists every value that still differs; the
+// service call itself succeeded, so the next read records the actual values.
+func waitForState(ctx context.Context, c *client.Client, entityID string, desired desiredState, timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if timeout <= 0 {
+		return diags
+	}
+
+	deadline := time.Now().Add(timeout)
+	interval := stateWaitIntervalMin
+
+	var mismatches []string
+	for {
+		state, err := c.GetStateContext(ctx, entityID)
+		if err != nil && !client.IsNotFound(err) {
+			return diag.FromErr(fmt.Errorf("failed to read state of %s: %w", entityID, err))
+		}
+
+		if state != nil {
+			mismatches = stateMismatches(state, desired)
+			if len(mismatches) == 0 {
+				return diags
+			}
+		} else {
+			mismatches = []string{"entity does not exist yet"}
+		}
+
+		if time.Now().Add(interval).After(deadline) {
+			break
+		}
+
+		select {
+		case <-time.After(interval):
+		case <-ctx.Done():
+			return diag.FromErr(fmt.Errorf("aborted waiting for %s to converge: %w", entityID, ctx.Err()))
+		}
+
+		interval *= 2
+		if interval > stateWaitIntervalMax {
+			interval = stateWaitIntervalMax
+		}
+	}
+
+	return append(diags, diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("%s did not reach the desired state within %s", entityID, timeout),
+		Detail: fmt.Sprintf("The following values never converged:\n  %s\n\n"+
+			"Home Assistant accepted the request, but the device may be slow to report or "+
+			"unable to apply it. Increase wait_timeout if the device needs more time.",
+			strings.Join(mismatches, "\n  ")),
+	})
+}
+
+// stateMismatches describes each way in which state differs from desired,
+// sorted by attribute name.
+func stateMismatches(state *client.State, desired desiredState) []string {
+	var mismatches []string
+
+	if desired.State != "" && state.State != desired.State {
+		mismatches = append(mismatches, fmt.Sprintf("state is %q, expected %q", state.State, desired.State))
+	}
+
+	names := make([]string, 0, len(desired.Attributes))
+	for name := range desired.Attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		want := desired.Attributes[name]
+		got, ok := state.Attributes[name]
+		if !ok {
+			mismatches = append(mismatches, fmt.Sprintf("%s is not reported, expected %v", name, want))
+			continue
+		}
+		if !attributeMatches(got, want) {
+			mismatches = append(mismatches, fmt.Sprintf("%s is %v, expected %v", name, got, want))
+		}
+	}
+
+	return mismatches
+}
+
+// attributeMatches compares a reported attribute with a desired value.
+// Numbers match within 2% (at least 1), since devices commonly round values
+// such as brightness or quantize color temperature. Lists match element-wise.
+func attributeMatches(got, want interface{}) bool {
+	if g, ok := toFloat(got); ok {
+		if w, ok := toFloat(want); ok {
+			tolerance := math.Max(1, math.Abs(w)*0.02)
+			return math.Abs(g-w) <= tolerance
+		}
+	}
+
+	gotList, gotIsList := toList(got)
+	wantList, wantIsList := toList(want)
+	if gotIsList && wantIsList {
+		if len(gotList) != len(wantList) {
+			return false
+		}
+		for i := range gotList {
+			if !attributeMatches(gotList[i], wantList[i]) {
+				return false
+			}
+		}
+		return true
+	}
+
+	return reflect.DeepEqual(got, want)
+}
+
+// toFloat converts JSON and Go numeric values to float64.
+func toFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	default:
+		return 0, false
+	}
+}
+
+// toList converts JSON arrays and Go slices to []interface{}.
+func toList(v interface{}) ([]interface{}, bool) {
+	if v == nil {
+		return nil, false
+	}
+
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice {
+		return nil, false
+	}
+
+	list := make([]interface{}, rv.Len())
+	for i := range list {
+		list[i] = rv.Index(i).Interface()
+	}
+	return list, true
+}
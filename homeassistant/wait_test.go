@@ -0,0 +1,150 @@
+package homeassistant
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/dawwestk/terraform-provider-homeassistant/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// newStateServer serves the state returned by next for every GetState call.
+func newStateServer(t *testing.T, next func(poll int32) client.State) *httptest.Server {
+	t.Helper()
+
+	var polls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(next(atomic.AddInt32(&polls, 1)))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestWaitForState_Converges(t *testing.T) {
+	server := newStateServer(t, func(poll int32) client.State {
+		if poll < 3 {
+			return client.State{EntityID: "light.desk", State: "off"}
+		}
+		return client.State{
+			EntityID:   "light.desk",
+			State:      "on",
+			Attributes: map[string]interface{}{"brightness": float64(200)},
+		}
+	})
+
+	desired := desiredState{State: "on", Attributes: map[string]interface{}{"brightness": 200}}
+	diags := waitForState(context.Background(), testClient(server), "light.desk", desired, 5*time.Second)
+	if len(diags) != 0 {
+		t.Fatalf("expected no diagnostics, got %v", diags)
+	}
+}
+
+func TestWaitForState_ReportsUnconvergedAttributes(t *testing.T) {
+	server := newStateServer(t, func(poll int32) client.State {
+		return client.State{
+			EntityID:   "light.desk",
+			State:      "on",
+			Attributes: map[string]interface{}{"brightness": float64(100)},
+		}
+	})
+
+	desired := desiredState{
+		State: "on",
+		Attributes: map[string]interface{}{
+			"brightness":        200,
+			"color_temp_kelvin": 2700,
+		},
+	}
+	diags := waitForState(context.Background(), testClient(server), "light.desk", desired, 300*time.Millisecond)
+
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("expected a single warning, got %v", diags)
+	}
+	if !strings.Contains(diags[0].Detail, "brightness is 100, expected 200") {
+		t.Errorf("expected brightness mismatch in detail, got %q", diags[0].Detail)
+	}
+	if !strings.Contains(diags[0].Detail, "color_temp_kelvin is not reported") {
+		t.Errorf("expected missing color_temp_kelvin in detail, got %q", diags[0].Detail)
+	}
+	if strings.Contains(diags[0].Detail, "state is") {
+		t.Errorf("expected state not to be reported as a mismatch, got %q", diags[0].Detail)
+	}
+}
+
+func TestWaitForState_ZeroTimeoutSkipsWaiting(t *testing.T) {
+	server := newStateServer(t, func(poll int32) client.State {
+		t.Error("expected no state to be polled")
+		return client.State{}
+	})
+
+	diags := waitForState(context.Background(), testClient(server), "light.desk", desiredState{State: "on"}, 0)
+	if len(diags) != 0 {
+		t.Fatalf("expected no diagnostics, got %v", diags)
+	}
+}
+
+func TestWaitForState_Cancelled(t *testing.T) {
+	server := newStateServer(t, func(poll int32) client.State {
+		return client.State{EntityID: "light.desk", State: "off"}
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 150*time.Millisecond)
+	defer cancel()
+
+	diags := waitForState(ctx, testClient(server), "light.desk", desiredState{State: "on"}, time.Minute)
+	if !diags.HasError() {
+		t.Fatal("expected error when the context is cancelled")
+	}
+}
+
+func TestAttributeMatches(t *testing.T) {
+	tests := []struct {
+		name     string
+		got      interface{}
+		want     interface{}
+		expected bool
+	}{
+		{"equal numbers", float64(200), 200, true},
+		{"rounded brightness", float64(199), 200, true},
+		{"quantized kelvin", float64(2702), 2700, true},
+		{"different numbers", float64(100), 200, false},
+		{"rgb list", []interface{}{float64(255), float64(0), float64(1)}, []int{255, 0, 0}, true},
+		{"rgb list mismatch", []interface{}{float64(0), float64(0), float64(255)}, []int{255, 0, 0}, false},
+		{"list length", []interface{}{float64(255)}, []int{255, 0, 0}, false},
+		{"strings", "rainbow", "rainbow", true},
+		{"different strings", "none", "rainbow", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := attributeMatches(tt.got, tt.want); got != tt.expected {
+				t.Errorf("attributeMatches(%v, %v) = %v, expected %v", tt.got, tt.want, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestStateWaitTimeout(t *testing.T) {
+	for name, r := range map[string]*schema.Resource{"light": resourceLight(), "switch": resourceSwitch()} {
+		t.Run(name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{})
+			if got := stateWaitTimeout(d); got != defaultStateWaitTimeout*time.Second {
+				t.Errorf("expected default wait timeout when unset, got %s", got)
+			}
+
+			d = schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{"wait_timeout": 30})
+			if got := stateWaitTimeout(d); got != 30*time.Second {
+				t.Errorf("expected 30s wait timeout, got %s", got)
+			}
+		})
+	}
+}